@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/epheo/anytype-go/internal/display"
+	"github.com/epheo/anytype-go/pkg/anytype"
+	"github.com/epheo/anytype-go/pkg/savedsearch"
+)
+
+// runCommand runs the subcommand given on the command line
+func runCommand(f *flags) error {
+	switch f.command[0] {
+	case "saved":
+		return runSavedCommand(f, f.command[1:], setupPrinter(f))
+	case "space":
+		return runClientCommand(f, runSpaceCommand)
+	case "member":
+		return runClientCommand(f, runMemberCommand)
+	case "schema":
+		return runClientCommand(f, runSchemaCommand)
+	default:
+		return fmt.Errorf("unknown command %q, expected saved, space, member or schema", f.command[0])
+	}
+}
+
+// clientCommand is a subcommand calling the API
+type clientCommand func(ctx context.Context, client *anytype.Client, f *flags, args []string, printer display.Printer) error
+
+// runClientCommand creates the API client and runs a subcommand with it
+func runClientCommand(f *flags, command clientCommand) error {
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	defer cancel()
+
+	client, printer, err := setupClient(f)
+	if err != nil {
+		return err
+	}
+
+	return command(ctx, client, f, f.command[1:], printer)
+}
+
+// isFlagSet reports whether a flag was given on the command line
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(fl *flag.Flag) {
+		if fl.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// runSavedCommand manages saved searches.
+//
+// Usage:
+//
+//	anytype-go saved list
+//	anytype-go saved save NAME [-space NAME] [-all-spaces] [-query QUERY] [-types TYPES] [-tags TAGS]
+//	anytype-go saved delete NAME
+func runSavedCommand(f *flags, args []string, printer display.Printer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing saved search command, expected list, save or delete")
+	}
+
+	store, err := savedsearch.DefaultStore()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "list":
+		searches, err := store.List()
+		if err != nil {
+			return err
+		}
+		if f.format == "json" {
+			return printer.PrintJSON("Saved searches", searches)
+		}
+		if len(searches) == 0 {
+			fmt.Printf("No saved searches in %s\n", store.Path())
+			return nil
+		}
+		for _, search := range searches {
+			query, err := json.Marshal(search.Query)
+			if err != nil {
+				return fmt.Errorf("failed to display saved search %s: %w", search.Name, err)
+			}
+			scope := ""
+			if search.AllSpaces {
+				scope = " (all spaces)"
+			} else if search.Space != "" {
+				scope = fmt.Sprintf(" (space %s)", search.Space)
+			}
+			fmt.Printf("%s%s: %s\n", search.Name, scope, query)
+		}
+		return nil
+
+	case "save":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: saved save NAME [search flags]")
+		}
+		search, err := savedSearchFromFlags(args[1], f)
+		if err != nil {
+			return err
+		}
+		if err := store.Save(*search); err != nil {
+			return fmt.Errorf("failed to save search: %w", err)
+		}
+		printer.PrintSuccess("Saved search %s", search.Name)
+		return nil
+
+	case "delete":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: saved delete NAME")
+		}
+		if err := store.Delete(args[1]); err != nil {
+			return fmt.Errorf("failed to delete search: %w", err)
+		}
+		printer.PrintSuccess("Deleted saved search %s", args[1])
+		return nil
+
+	default:
+		return fmt.Errorf("unknown saved search command %q, expected list, save or delete", args[0])
+	}
+}
+
+// savedSearchFromFlags builds a saved search from the search flags.
+// Type names are saved as is and resolved when the search is run.
+func savedSearchFromFlags(name string, f *flags) (*savedsearch.SavedSearch, error) {
+	parsed, err := anytype.ParseQuery(f.query)
+	if err != nil {
+		return nil, formatQueryError(err)
+	}
+
+	def := parsed.Definition()
+	if f.types != "" {
+		def.Types = append(def.Types, splitList(f.types)...)
+	} else if f.typeName != "" {
+		def.Types = append(def.Types, strings.TrimSpace(f.typeName))
+	}
+	def.Tags = append(def.Tags, splitList(f.tags)...)
+
+	return &savedsearch.SavedSearch{
+		Name:      name,
+		Space:     f.spaceName,
+		AllSpaces: f.allSpaces,
+		Query:     *def,
+	}, nil
+}
+
+// splitList splits a comma-separated list, dropping empty items
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
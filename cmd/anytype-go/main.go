@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"github.com/epheo/anytype-go/internal/display"
 	"github.com/epheo/anytype-go/pkg/anytype"
 	"github.com/epheo/anytype-go/pkg/auth"
+	"github.com/epheo/anytype-go/pkg/savedsearch"
 )
 
 // Command line flags
@@ -30,6 +32,15 @@ type flags struct {
 	exportPath   string // Path to export files to
 	exportFormat string // Format to export objects as (md, html, etc.)
 	version      bool   // Display version information
+	allSpaces    bool   // Search across all spaces
+	saved        string // Name of a saved search to run
+	name         string // Name given by commands that create or rename resources
+	description  string // Description given by commands that create or update resources
+	icon         string // Emoji icon given by commands that create or update resources
+	role         string // Member role given by 'member approve'
+	file         string // Manifest file given by 'schema plan' and 'schema apply'
+	dryRun       bool   // Print the changes 'schema apply' would make without making them
+	command      []string
 }
 
 // exportOptions defines options for exporting objects
@@ -68,15 +79,7 @@ func main() {
 //   - Any error encountered during setup
 func setupClient(f *flags) (*anytype.Client, display.Printer, error) {
 	// Initialize display
-	printer := display.NewPrinter(f.format, !f.noColor, f.debug)
-
-	// Set log level (debug flag overrides loglevel flag)
-	if f.debug {
-		printer.SetLogLevel(display.LogLevelDebug)
-	} else {
-		level := display.ParseLogLevel(f.logLevel)
-		printer.SetLogLevel(level)
-	}
+	printer := setupPrinter(f)
 
 	// Initialize auth manager with options
 	authManager := auth.NewAuthManager(
@@ -100,6 +103,21 @@ func setupClient(f *flags) (*anytype.Client, display.Printer, error) {
 	return client, printer, nil
 }
 
+// setupPrinter creates the display printer configured by the command line flags
+func setupPrinter(f *flags) display.Printer {
+	printer := display.NewPrinter(f.format, !f.noColor, f.debug)
+
+	// Set log level (debug flag overrides loglevel flag)
+	if f.debug {
+		printer.SetLogLevel(display.LogLevelDebug)
+	} else {
+		level := display.ParseLogLevel(f.logLevel)
+		printer.SetLogLevel(level)
+	}
+
+	return printer
+}
+
 // setupSpaces gets and displays spaces, and finds the target space.
 //
 // This function retrieves all available spaces from the Anytype API, displays them
@@ -171,7 +189,7 @@ func findTargetSpace(spaces *anytype.SpacesResponse, spaceName string, printer d
 }
 
 // processTypeFilters resolves type names to type keys for search filtering
-func processTypeFilters(ctx context.Context, client *anytype.Client, spaceID string, typeNames []string, printer display.Printer) ([]string, []string) {
+func processTypeFilters(ctx context.Context, client *anytype.Client, spaceIDs []string, typeNames []string, printer display.Printer) ([]string, []string) {
 	typeKeys := []string{}
 	typeNamesFound := []string{}
 
@@ -181,20 +199,47 @@ func processTypeFilters(ctx context.Context, client *anytype.Client, spaceID str
 			continue
 		}
 
-		typeKey, err := client.GetTypeByName(ctx, spaceID, typeName)
+		keys, err := resolveTypeName(ctx, client, spaceIDs, typeName)
 		if err != nil {
 			printer.PrintError("Could not find type '%s': %v", typeName, err)
-		} else if typeKey != "" {
-			typeKeys = append(typeKeys, typeKey)
-			typeNamesFound = append(typeNamesFound, typeName)
-		} else {
-			printer.PrintError("Type key for '%s' resolved to an empty string, skipping", typeName)
+			continue
 		}
+		typeKeys = append(typeKeys, keys...)
+		typeNamesFound = append(typeNamesFound, typeName)
 	}
 
 	return typeKeys, typeNamesFound
 }
 
+// resolveTypeName resolves a type name to its key in each of the given spaces.
+//
+// Type keys can differ between spaces, so the distinct keys found in any of
+// the spaces are returned. It fails only when no space has the type.
+func resolveTypeName(ctx context.Context, client *anytype.Client, spaceIDs []string, typeName string) ([]string, error) {
+	var typeKeys []string
+	seen := make(map[string]bool)
+	var lastErr error
+	for _, spaceID := range spaceIDs {
+		typeKey, err := client.GetTypeByName(ctx, spaceID, typeName)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if typeKey != "" && !seen[typeKey] {
+			seen[typeKey] = true
+			typeKeys = append(typeKeys, typeKey)
+		}
+	}
+
+	if len(typeKeys) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("type key for '%s' resolved to an empty string", typeName)
+		}
+		return nil, lastErr
+	}
+	return typeKeys, nil
+}
+
 // handleSearch performs the search operation with the given parameters
 func handleSearch(ctx context.Context, client *anytype.Client, targetSpace *anytype.Space, params *anytype.SearchParams, printer display.Printer, exportOptions *exportOptions) error {
 	results, err := client.Search(ctx, targetSpace.ID, params)
@@ -208,27 +253,71 @@ func handleSearch(ctx context.Context, client *anytype.Client, targetSpace *anyt
 
 	// Handle export if enabled
 	if exportOptions != nil && exportOptions.enabled {
-		printer.PrintInfo("Exporting %d objects to %s in %s format", len(results.Data), exportOptions.path, exportOptions.format)
+		return exportResults(ctx, client, targetSpace.ID, results.Data, printer, exportOptions)
+	}
 
-		// Create export directory if it doesn't exist
-		if err := os.MkdirAll(exportOptions.path, 0755); err != nil {
-			return fmt.Errorf("failed to create export directory: %w", err)
-		}
+	return nil
+}
 
-		exportedFiles, err := client.ExportObjects(ctx, targetSpace.ID, results.Data, exportOptions.path, exportOptions.format)
-		if err != nil {
-			return fmt.Errorf("export failed: %w", err)
-		}
+// handleSearchAll performs the search operation across all spaces
+func handleSearchAll(ctx context.Context, client *anytype.Client, params *anytype.SearchParams, printer display.Printer, exportOptions *exportOptions) error {
+	results, err := client.SearchAll(ctx, params)
+	var spaceErr *anytype.SpaceSearchError
+	if errors.As(err, &spaceErr) && results != nil {
+		// Show the results of the other spaces
+		printer.PrintError("Some spaces could not be searched: %v", err)
+	} else if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+
+	if err := printer.PrintObjects("Search Results (all spaces)", results.Data, client, ctx); err != nil {
+		return fmt.Errorf("failed to display search results: %w", err)
+	}
 
-		printer.PrintSuccess("Successfully exported %d objects:", len(exportedFiles))
-		for i, file := range exportedFiles {
-			printer.PrintInfo("  %d. %s", i+1, file)
+	if exportOptions == nil || !exportOptions.enabled {
+		return nil
+	}
+
+	// Export the objects of each space separately
+	bySpace := make(map[string][]anytype.Object)
+	var spaceIDs []string
+	for _, obj := range results.Data {
+		if _, ok := bySpace[obj.SpaceID]; !ok {
+			spaceIDs = append(spaceIDs, obj.SpaceID)
+		}
+		bySpace[obj.SpaceID] = append(bySpace[obj.SpaceID], obj)
+	}
+	for _, spaceID := range spaceIDs {
+		if err := exportResults(ctx, client, spaceID, bySpace[spaceID], printer, exportOptions); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// exportResults exports objects of a space to files
+func exportResults(ctx context.Context, client *anytype.Client, spaceID string, objects []anytype.Object, printer display.Printer, exportOptions *exportOptions) error {
+	printer.PrintInfo("Exporting %d objects to %s in %s format", len(objects), exportOptions.path, exportOptions.format)
+
+	// Create export directory if it doesn't exist
+	if err := os.MkdirAll(exportOptions.path, 0755); err != nil {
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	exportedFiles, err := client.ExportObjects(ctx, spaceID, objects, exportOptions.path, exportOptions.format)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	printer.PrintSuccess("Successfully exported %d objects:", len(exportedFiles))
+	for i, file := range exportedFiles {
+		printer.PrintInfo("  %d. %s", i+1, file)
+	}
+
+	return nil
+}
+
 // handleDefaultExport performs a default export of all objects when no search parameters are provided
 func handleDefaultExport(ctx context.Context, client *anytype.Client, targetSpace *anytype.Space, exportOpts *exportOptions, printer display.Printer) error {
 	printer.PrintInfo("No search parameters provided, exporting all objects from space %s (%s)", targetSpace.Name, targetSpace.ID)
@@ -246,7 +335,7 @@ func handleDefaultExport(ctx context.Context, client *anytype.Client, targetSpac
 // This function constructs a SearchParams object using the search criteria provided
 // in the command line flags. It handles:
 //
-// 1. Setting the text query from the -query flag
+// 1. Parsing the -query flag with the query language (see anytype.ParseQuery)
 // 2. Processing type filters from either -types (comma-separated) or -type (single)
 // 3. Converting type names to type keys by querying the Anytype API
 // 4. Adding tag filters from the -tags flag
@@ -257,17 +346,47 @@ func handleDefaultExport(ctx context.Context, client *anytype.Client, targetSpac
 // Parameters:
 //   - ctx: Context for the API request
 //   - client: The initialized Anytype API client
-//   - spaceID: ID of the space to search within
+//   - spaceIDs: IDs of the spaces searched, in which type names are resolved
 //   - f: Parsed command line flags containing search criteria
+//   - saved: Saved search to start from, or nil
 //   - printer: Display printer for output formatting
 //
 // Returns:
 //   - A populated SearchParams object ready for use with the Search API
 //   - Any error encountered during parameter preparation
-func prepareSearchParams(ctx context.Context, client *anytype.Client, spaceID string, f *flags, printer display.Printer) (*anytype.SearchParams, error) {
-	searchParams := &anytype.SearchParams{
-		Query: strings.TrimSpace(f.query),
-		Limit: 100,
+func prepareSearchParams(ctx context.Context, client *anytype.Client, spaceIDs []string, f *flags, saved *savedsearch.SavedSearch, printer display.Printer) (*anytype.SearchParams, error) {
+	// Parse the query language of the -query flag
+	parsed, err := anytype.ParseQuery(f.query)
+	if err != nil {
+		return nil, formatQueryError(err)
+	}
+
+	var defs []*anytype.QueryDefinition
+	if saved != nil {
+		printer.PrintInfo("Running saved search: %s", saved.Name)
+		def := saved.Query
+		defs = append(defs, &def)
+	}
+	defs = append(defs, parsed.Definition())
+
+	// Type names are resolved below in every searched space rather than by the query builder
+	var queryTypeNames []string
+	qb := client.NewQueryBuilder(spaceIDs[0]).WithLimit(100)
+	for _, def := range defs {
+		queryTypeNames = append(queryTypeNames, def.Types...)
+		def.Types = nil
+		qb.FromDefinition(ctx, def)
+	}
+	searchParams, err := qb.GetParams()
+	if err != nil {
+		return nil, formatQueryError(err)
+	}
+	for _, typeName := range queryTypeNames {
+		typeKeys, err := resolveTypeName(ctx, client, spaceIDs, typeName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve type name '%s': %w", typeName, err)
+		}
+		searchParams.Types = append(searchParams.Types, typeKeys...)
 	}
 
 	// Process type filters (priority given to -types over -type for backwards compatibility)
@@ -277,16 +396,16 @@ func prepareSearchParams(ctx context.Context, client *anytype.Client, spaceID st
 	if f.types != "" {
 		// Handle multiple types
 		typeNames := strings.Split(f.types, ",")
-		typeKeys, typeNamesFound = processTypeFilters(ctx, client, spaceID, typeNames, printer)
+		typeKeys, typeNamesFound = processTypeFilters(ctx, client, spaceIDs, typeNames, printer)
 	} else if f.typeName != "" {
 		// For backward compatibility: handle single type
-		typeKeys, typeNamesFound = processTypeFilters(ctx, client, spaceID, []string{f.typeName}, printer)
+		typeKeys, typeNamesFound = processTypeFilters(ctx, client, spaceIDs, []string{f.typeName}, printer)
 	}
 
 	if len(typeKeys) > 0 {
-		searchParams.Types = typeKeys
+		searchParams.Types = append(searchParams.Types, typeKeys...)
 		printer.PrintInfo("Filtering search results by types: %s", strings.Join(typeNamesFound, ", "))
-	} else {
+	} else if len(searchParams.Types) == 0 {
 		printer.PrintInfo("No valid types found, proceeding with search without type filtering")
 	}
 
@@ -296,13 +415,22 @@ func prepareSearchParams(ctx context.Context, client *anytype.Client, spaceID st
 		for i := range tags {
 			tags[i] = strings.TrimSpace(tags[i])
 		}
-		searchParams.Tags = tags
+		searchParams.Tags = append(searchParams.Tags, tags...)
 		printer.PrintInfo("Filtering search results by tags: %s", strings.Join(tags, ", "))
 	}
 
 	return searchParams, nil
 }
 
+// formatQueryError shows the position of query syntax errors
+func formatQueryError(err error) error {
+	var parseErr *anytype.QueryParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("%w\n  %s\n  %s^", err, parseErr.Input, strings.Repeat(" ", parseErr.Pos))
+	}
+	return err
+}
+
 // setupExportOptions creates export options if export is enabled
 func setupExportOptions(f *flags, printer display.Printer) *exportOptions {
 	if !f.export {
@@ -330,6 +458,7 @@ func setupExportOptions(f *flags, printer display.Printer) *exportOptions {
 //   - client: The initialized Anytype API client
 //   - targetSpace: The space to search within
 //   - f: Parsed command line flags containing search criteria
+//   - saved: Saved search selected with -saved, or nil
 //   - printer: Display printer for output formatting
 //   - exportOpts: Export options if export is enabled, or nil
 //
@@ -338,14 +467,33 @@ func setupExportOptions(f *flags, printer display.Printer) *exportOptions {
 //
 // This function is called from the main run() function when search parameters
 // are detected in the command line flags.
-func handleSearchCase(ctx context.Context, client *anytype.Client, targetSpace *anytype.Space, f *flags, printer display.Printer, exportOpts *exportOptions) error {
+func handleSearchCase(ctx context.Context, client *anytype.Client, targetSpace *anytype.Space, f *flags, saved *savedsearch.SavedSearch, printer display.Printer, exportOpts *exportOptions) error {
+	// Type names are resolved in every space searched
+	spaceIDs := []string{targetSpace.ID}
+	if f.allSpaces {
+		spaces, err := client.SpacesPager().All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get spaces: %w", err)
+		}
+		spaceIDs = spaceIDs[:0]
+		for _, space := range spaces {
+			spaceIDs = append(spaceIDs, space.ID)
+		}
+		if len(spaceIDs) == 0 {
+			return fmt.Errorf("no spaces available")
+		}
+	}
+
 	// Prepare search parameters
-	searchParams, err := prepareSearchParams(ctx, client, targetSpace.ID, f, printer)
+	searchParams, err := prepareSearchParams(ctx, client, spaceIDs, f, saved, printer)
 	if err != nil {
 		return err
 	}
 
 	// Execute search and handle results
+	if f.allSpaces {
+		return handleSearchAll(ctx, client, searchParams, printer, exportOpts)
+	}
 	return handleSearch(ctx, client, targetSpace, searchParams, printer, exportOpts)
 }
 
@@ -360,6 +508,27 @@ func run() error {
 		return nil
 	}
 
+	// Run subcommands such as "saved list"
+	if len(f.command) > 0 {
+		return runCommand(f)
+	}
+
+	// Load the saved search, its space and scope apply unless overridden
+	var saved *savedsearch.SavedSearch
+	if f.saved != "" {
+		store, err := savedsearch.DefaultStore()
+		if err != nil {
+			return err
+		}
+		if saved, err = store.Get(f.saved); err != nil {
+			return err
+		}
+		if f.spaceName == "" {
+			f.spaceName = saved.Space
+		}
+		f.allSpaces = f.allSpaces || saved.AllSpaces
+	}
+
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
 	defer cancel()
@@ -380,11 +549,11 @@ func run() error {
 	exportOpts := setupExportOptions(f, printer)
 
 	// Determine if we need to perform a search
-	hasSearchParams := f.query != "" || f.tags != "" || f.typeName != "" || f.types != ""
+	hasSearchParams := f.query != "" || f.tags != "" || f.typeName != "" || f.types != "" || saved != nil
 
 	if hasSearchParams {
 		// Handle search case
-		if err := handleSearchCase(ctx, client, targetSpace, f, printer, exportOpts); err != nil {
+		if err := handleSearchCase(ctx, client, targetSpace, f, saved, printer, exportOpts); err != nil {
 			return err
 		}
 	} else if f.export {
@@ -398,6 +567,9 @@ func run() error {
 		searchParams := &anytype.SearchParams{
 			Limit: 100,
 		}
+		if f.allSpaces {
+			return handleSearchAll(ctx, client, searchParams, printer, exportOpts)
+		}
 		if err := handleSearch(ctx, client, targetSpace, searchParams, printer, exportOpts); err != nil {
 			return err
 		}
@@ -417,9 +589,19 @@ func parseFlags() *flags {
 	flag.StringVar(&f.spaceName, "space", "", "Space name to use")
 	flag.StringVar(&f.typeName, "type", "", "Type name to look for (deprecated, use -types instead)")
 	flag.StringVar(&f.types, "types", "", "Comma-separated list of type names to filter by (e.g., 'Note,Task')")
-	flag.StringVar(&f.query, "query", "", "Search query")
+	flag.StringVar(&f.query, "query", "", "Search query (e.g., 'type:Task tag:urgent -tag:done due<2025-06-01 meeting')")
 	flag.StringVar(&f.tags, "tags", "", "Comma-separated list of tags to filter by (e.g., 'important,work')")
 	flag.BoolVar(&f.curl, "curl", false, "Print curl equivalent of API requests")
+	flag.BoolVar(&f.allSpaces, "all-spaces", false, "Search across all spaces instead of a single one")
+	flag.StringVar(&f.saved, "saved", "", "Name of a saved search to run (see 'saved list')")
+
+	// Options of the commands managing resources
+	flag.StringVar(&f.name, "name", "", "New name, for commands such as 'space update'")
+	flag.StringVar(&f.description, "description", "", "Description, for commands such as 'space create'")
+	flag.StringVar(&f.icon, "icon", "", "Emoji icon, for commands such as 'space create'")
+	flag.StringVar(&f.role, "role", "", "Member role (viewer or editor) for 'member approve' [default: viewer]")
+	flag.StringVar(&f.file, "f", "", "Schema manifest YAML or JSON file, for 'schema plan' and 'schema apply'")
+	flag.BoolVar(&f.dryRun, "dry-run", false, "Print the changes 'schema apply' would make without making them")
 
 	// Export options
 	flag.BoolVar(&f.export, "export", false, "Export objects as files")
@@ -429,7 +611,14 @@ func parseFlags() *flags {
 	// Version information
 	flag.BoolVar(&f.version, "version", false, "Display version information")
 
-	flag.Parse()
+	// Commands may come before the flags, as in "saved save NAME -query ...", or after them
+	args := os.Args[1:]
+	for len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		f.command = append(f.command, args[0])
+		args = args[1:]
+	}
+	flag.CommandLine.Parse(args)
+	f.command = append(f.command, flag.Args()...)
 
 	return f
 }
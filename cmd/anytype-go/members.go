@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/epheo/anytype-go/internal/display"
+	"github.com/epheo/anytype-go/pkg/anytype"
+)
+
+// runMemberCommand manages the members of a space.
+//
+// Usage:
+//
+//	anytype-go member list SPACE
+//	anytype-go member get SPACE MEMBER
+//	anytype-go member approve SPACE MEMBER [-role viewer|editor]
+//	anytype-go member decline SPACE MEMBER
+//	anytype-go member role SPACE MEMBER viewer|editor|owner
+//	anytype-go member remove SPACE MEMBER
+//
+// SPACE is the name or the ID of a space and MEMBER the ID, name or global
+// name of a member.
+func runMemberCommand(ctx context.Context, client *anytype.Client, f *flags, args []string, printer display.Printer) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: member list|get|approve|decline|role|remove SPACE [MEMBER]")
+	}
+
+	space, err := resolveSpace(ctx, client, args[1])
+	if err != nil {
+		return err
+	}
+
+	if args[0] == "list" {
+		members, err := client.GetMembers(ctx, space.ID)
+		if err != nil {
+			return err
+		}
+		return printer.PrintJSON("Members", members.Data)
+	}
+
+	if len(args) < 3 {
+		return fmt.Errorf("usage: member %s SPACE MEMBER", args[0])
+	}
+	member, err := resolveMember(ctx, client, space.ID, args[2])
+	if err != nil {
+		return err
+	}
+
+	var updated *anytype.Member
+	switch args[0] {
+	case "get":
+		return printer.PrintJSON("Member", member)
+	case "approve":
+		role := anytype.MemberRole(f.role)
+		if role == "" {
+			role = anytype.MemberRoleViewer
+		}
+		updated, err = client.ApproveMember(ctx, space.ID, member.ID, role)
+	case "decline":
+		updated, err = client.DeclineMember(ctx, space.ID, member.ID)
+	case "role":
+		if len(args) != 4 {
+			return fmt.Errorf("usage: member role SPACE MEMBER viewer|editor|owner")
+		}
+		updated, err = client.UpdateMemberRole(ctx, space.ID, member.ID, anytype.MemberRole(args[3]))
+	case "remove":
+		updated, err = client.RemoveMember(ctx, space.ID, member.ID)
+	default:
+		return fmt.Errorf("unknown member command %q, expected list, get, approve, decline, role or remove", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	printer.PrintSuccess("Updated member %s: role %s, status %s", updated.Name, updated.Role, updated.Status)
+	return printer.PrintJSON("Member", updated)
+}
+
+// resolveMember finds a member of a space by ID, name or global name
+func resolveMember(ctx context.Context, client *anytype.Client, spaceID, member string) (*anytype.Member, error) {
+	members, err := client.GetMembers(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range members.Data {
+		m := &members.Data[i]
+		if m.ID == member || m.Name == member || m.GlobalName == member {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: member %s", anytype.ErrNotFound, member)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/epheo/anytype-go/internal/display"
+	"github.com/epheo/anytype-go/pkg/anytype"
+	"github.com/epheo/anytype-go/pkg/schema"
+)
+
+// runSchemaCommand provisions types, properties and tags from a manifest.
+//
+// Usage:
+//
+//	anytype-go schema plan -f FILE -space SPACE
+//	anytype-go schema apply -f FILE -space SPACE [-dry-run]
+//
+// SPACE is the name or the ID of a space.
+func runSchemaCommand(ctx context.Context, client *anytype.Client, f *flags, args []string, printer display.Printer) error {
+	if len(args) != 1 || (args[0] != "plan" && args[0] != "apply") {
+		return fmt.Errorf("usage: schema plan|apply -f FILE -space SPACE")
+	}
+	if f.file == "" {
+		return fmt.Errorf("missing manifest, use -f FILE")
+	}
+	if f.spaceName == "" {
+		return fmt.Errorf("missing space, use -space SPACE")
+	}
+
+	manifest, err := schema.Load(f.file)
+	if err != nil {
+		return err
+	}
+	space, err := resolveSpace(ctx, client, f.spaceName)
+	if err != nil {
+		return err
+	}
+
+	plan, err := schema.NewPlan(ctx, client, space.ID, manifest)
+	if err != nil {
+		return err
+	}
+	if f.format == "json" {
+		if err := printer.PrintJSON("Plan", plan); err != nil {
+			return err
+		}
+	} else {
+		fmt.Println(plan)
+	}
+
+	if args[0] == "plan" || f.dryRun || plan.IsEmpty() {
+		return nil
+	}
+	if err := plan.Apply(ctx, client); err != nil {
+		return err
+	}
+	printer.PrintSuccess("Applied %d changes to space %s", len(plan.Actions), space.Name)
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/epheo/anytype-go/internal/display"
+	"github.com/epheo/anytype-go/pkg/anytype"
+)
+
+// runSpaceCommand manages spaces.
+//
+// Usage:
+//
+//	anytype-go space create NAME [-description TEXT] [-icon EMOJI]
+//	anytype-go space update SPACE [-name NAME] [-description TEXT] [-icon EMOJI]
+//	anytype-go space describe SPACE
+//
+// SPACE is the name or the ID of a space.
+func runSpaceCommand(ctx context.Context, client *anytype.Client, f *flags, args []string, printer display.Printer) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: space create NAME | space update SPACE | space describe SPACE")
+	}
+
+	switch args[0] {
+	case "create":
+		params := &anytype.CreateSpaceParams{
+			Name:        args[1],
+			Description: f.description,
+			Icon:        emojiIcon(f.icon),
+		}
+		space, err := client.CreateSpace(ctx, params)
+		if err != nil {
+			return err
+		}
+		printer.PrintSuccess("Created space %s (%s)", space.Name, space.ID)
+		return printer.PrintJSON("Space", space)
+
+	case "update":
+		space, err := resolveSpace(ctx, client, args[1])
+		if err != nil {
+			return err
+		}
+		params := &anytype.UpdateSpaceParams{SpaceID: space.ID, Icon: emojiIcon(f.icon)}
+		if isFlagSet("name") {
+			params.Name = &f.name
+		}
+		if isFlagSet("description") {
+			params.Description = &f.description
+		}
+		updated, err := client.UpdateSpace(ctx, params)
+		if err != nil {
+			return err
+		}
+		printer.PrintSuccess("Updated space %s (%s)", updated.Name, updated.ID)
+		return printer.PrintJSON("Space", updated)
+
+	case "describe":
+		space, err := resolveSpace(ctx, client, args[1])
+		if err != nil {
+			return err
+		}
+		details, err := client.GetSpaceByID(ctx, space.ID)
+		if err != nil {
+			return err
+		}
+		if err := printer.PrintJSON("Space", details); err != nil {
+			return err
+		}
+		members, err := client.GetMembers(ctx, space.ID)
+		if err != nil {
+			return err
+		}
+		return printer.PrintJSON("Members", members.Data)
+
+	default:
+		return fmt.Errorf("unknown space command %q, expected create, update or describe", args[0])
+	}
+}
+
+// resolveSpace finds a space by ID or by name
+func resolveSpace(ctx context.Context, client *anytype.Client, nameOrID string) (*anytype.Space, error) {
+	spaces, err := client.SpacesPager().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spaces: %w", err)
+	}
+
+	for i := range spaces {
+		if spaces[i].ID == nameOrID {
+			return &spaces[i], nil
+		}
+	}
+	for i := range spaces {
+		if spaces[i].Name == nameOrID {
+			return &spaces[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", anytype.ErrSpaceNotFound, nameOrID)
+}
+
+// emojiIcon returns an emoji icon, nil if emoji is empty
+func emojiIcon(emoji string) *anytype.Icon {
+	if emoji == "" {
+		return nil
+	}
+	return &anytype.Icon{Format: "emoji", Emoji: emoji}
+}
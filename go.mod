@@ -2,6 +2,9 @@ module github.com/epheo/anytype-go
 
 go 1.21
 
-require github.com/olekukonko/tablewriter v0.0.5
+require (
+	github.com/olekukonko/tablewriter v0.0.5
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require github.com/mattn/go-runewidth v0.0.9 // indirect
@@ -126,7 +126,10 @@ func (p *printer) PrintSpaces(spaces []anytype.Space) error {
 
 		// Format members list
 		members := "-"
-		if len(space.Members) > 0 {
+		if space.MembersErr != nil {
+			members = "(unavailable)"
+			p.PrintDebug("Members of space %s unavailable: %v", space.Name, space.MembersErr)
+		} else if len(space.Members) > 0 {
 			memberStrs := make([]string, 0, len(space.Members))
 			for _, member := range space.Members {
 				memberStr := member.Name
@@ -73,9 +73,10 @@ func isTagProperty(prop Property) bool {
 
 // SearchRequestBody represents the structure of a search request
 type SearchRequestBody struct {
-	Query string       `json:"query,omitempty"`
-	Types []string     `json:"types,omitempty"`
-	Sort  *SortOptions `json:"sort,omitempty"`
+	Query   string            `json:"query,omitempty"`
+	Types   []string          `json:"types,omitempty"`
+	Sort    *SortOptions      `json:"sort,omitempty"`
+	Filters *FilterExpression `json:"filters,omitempty"`
 	// These fields are for internal use and not part of the official API
 	SpaceID string      `json:"spaceId,omitempty"`
 	Tags    []string    `json:"tags,omitempty"`
@@ -88,20 +89,17 @@ type SearchRequestBody struct {
 // TypeResponse represents the structure of a type response
 type TypeResponse struct {
 	Data       []TypeInfo `json:"data"`
-	Pagination struct {
-		Total   int  `json:"total"`
-		Offset  int  `json:"offset"`
-		Limit   int  `json:"limit"`
-		HasMore bool `json:"has_more"`
-	} `json:"pagination"`
+	Pagination Pagination `json:"pagination"`
 }
 
 // GetSpaces retrieves all available spaces from the Anytype API.
 //
-// This method fetches all spaces that the authenticated user has access to.
-// For each space, it also attempts to fetch and populate the space's members.
-// If member fetching fails for any space, the error is logged (in debug mode) but
-// the space is still included in the results.
+// This method fetches all spaces that the authenticated user has access to,
+// walking through all result pages. For each space, it also fetches and
+// populates the space's members. If member fetching fails for a space, the
+// space is still included in the results with its MembersErr field set.
+//
+// Use GetSpacesWithParams to list spaces without their members.
 //
 // Example:
 //
@@ -115,9 +113,16 @@ type TypeResponse struct {
 //	    fmt.Printf("- %s (ID: %s)\n", space.Name, space.ID)
 //	}
 func (c *Client) GetSpaces(ctx context.Context) (*SpacesResponse, error) {
-	data, err := c.makeRequest(ctx, http.MethodGet, "/v1/spaces", nil)
+	return c.GetSpacesWithParams(ctx, NewGetSpacesParams())
+}
+
+// getSpacesPage retrieves a single page of spaces
+func (c *Client) getSpacesPage(ctx context.Context, offset, limit int) ([]Space, Pagination, error) {
+	path := fmt.Sprintf("/v1/spaces?offset=%d&limit=%d", offset, limit)
+	ctx = withOperation(ctx, "GetSpaces", "/v1/spaces")
+	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, wrapError("/v1/spaces", 0, "failed to get spaces", err)
+		return nil, Pagination{}, wrapError("/v1/spaces", 0, "failed to get spaces", err)
 	}
 
 	if c.debug && c.logger != nil {
@@ -126,56 +131,34 @@ func (c *Client) GetSpaces(ctx context.Context) (*SpacesResponse, error) {
 
 	var response SpacesResponse
 	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, wrapError("/v1/spaces", 0, "failed to parse spaces response", err)
+		return nil, Pagination{}, wrapError("/v1/spaces", 0, "failed to parse spaces response", err)
 	}
 
-	// Fetch members for each space
-	for i := range response.Data {
-		if c.debug && c.logger != nil {
-			c.logger.Debug("Fetching members for space %s (%s)", response.Data[i].Name, response.Data[i].ID)
-		}
-
-		members, err := c.GetMembers(ctx, response.Data[i].ID)
-		if err != nil {
-			if c.debug && c.logger != nil {
-				c.logger.Debug("Warning: failed to get members for space %s: %v", response.Data[i].ID, err)
-			}
-			continue
-		}
-
-		response.Data[i].Members = members.Data
-	}
-
-	return &response, nil
+	return response.Data, response.Pagination, nil
 }
 
-// GetSpaceByID retrieves a specific space by ID
+// GetSpaceByID retrieves a specific space by ID, including the IDs of its
+// home, archive, profile and workspace objects
 func (c *Client) GetSpaceByID(ctx context.Context, spaceID string) (*Space, error) {
 	if spaceID == "" {
 		return nil, wrapError("/v1/spaces/{id}", 0, "space ID is required", ErrInvalidSpaceID)
 	}
 
 	path := fmt.Sprintf("/v1/spaces/%s", spaceID)
+	ctx = withOperation(ctx, "GetSpaceByID", "/v1/spaces/{space_id}")
 	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, wrapError(path, 0, fmt.Sprintf("failed to get space %s", spaceID), err)
 	}
 
-	if c.debug && c.logger != nil {
-		c.logger.Debug("Raw space response: %s", string(data))
-	}
-
-	var response struct {
-		Space Space `json:"space"`
-	}
-	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, wrapError(path, 0, "failed to parse space response", err)
-	}
-
-	return &response.Space, nil
+	return c.parseSpaceResponse(path, data)
 }
 
-// GetTypes retrieves types from a space
+// GetTypes retrieves types from a space.
+//
+// When params.Limit is 0, all types starting at params.Offset are fetched by
+// walking through all result pages, and the complete list replaces the cached
+// types of the space. Otherwise a single page is returned.
 func (c *Client) GetTypes(ctx context.Context, params *GetTypesParams) (*TypeResponse, error) {
 	if params == nil {
 		return nil, ErrInvalidParameter
@@ -184,30 +167,49 @@ func (c *Client) GetTypes(ctx context.Context, params *GetTypesParams) (*TypeRes
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/v1/spaces/%s/types", params.SpaceID)
+	// Return a single page if a limit was requested
+	if params.Limit > 0 {
+		types, pagination, err := c.getTypesPage(ctx, params.SpaceID, params.Offset, params.Limit)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeResponse{Data: types, Pagination: pagination}, nil
+	}
+
+	types, err := c.TypesPager(params.SpaceID).WithOffset(params.Offset).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the type cache with the complete list of types
+	if params.Offset == 0 {
+		c.typeCache.store(params.SpaceID, types)
+	}
+
+	return &TypeResponse{Data: types, Pagination: completePagination(len(types))}, nil
+}
+
+// getTypesPage retrieves a single page of types without touching the cache
+func (c *Client) getTypesPage(ctx context.Context, spaceID string, offset, limit int) ([]TypeInfo, Pagination, error) {
+	path := fmt.Sprintf("/v1/spaces/%s/types?offset=%d&limit=%d", spaceID, offset, limit)
+	ctx = withOperation(ctx, "GetTypes", "/v1/spaces/{space_id}/types")
 	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get types for space %s: %w", params.SpaceID, err)
+		return nil, Pagination{}, fmt.Errorf("failed to get types for space %s: %w", spaceID, err)
 	}
 
 	// This follows the API's pagination response format for types
 	var response TypeResponse
 	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, fmt.Errorf("failed to parse types response: %w", err)
-	}
-
-	// Update the type cache with the retrieved types
-	// Initialize cache for this space if needed
-	if _, ok := c.typeCache[params.SpaceID]; !ok {
-		c.typeCache[params.SpaceID] = make(map[string]string)
+		return nil, Pagination{}, fmt.Errorf("failed to parse types response: %w", err)
 	}
 
-	// Update cache with all types
-	for _, t := range response.Data {
-		c.typeCache[params.SpaceID][t.Key] = t.Name
-	}
+	return response.Data, response.Pagination, nil
+}
 
-	return &response, nil
+// fetchTypes fetches all types of a space for the type cache
+func (c *Client) fetchTypes(ctx context.Context, spaceID string) ([]TypeInfo, error) {
+	return c.TypesPager(spaceID).All(ctx)
 }
 
 // GetTypeByName retrieves key for a specific type by its name
@@ -219,59 +221,53 @@ func (c *Client) GetTypeByName(ctx context.Context, spaceID, typeName string) (s
 		return "", ErrInvalidTypeID
 	}
 
-	// Initialize cache for this space if needed
-	c.initializeTypeCache(spaceID)
+	// First try to find from the cache, fetching the types if the space isn't cached yet
+	names, fetched, err := c.cachedTypeNames(ctx, spaceID)
+	if err != nil {
+		return "", err
+	}
 
-	// First try to find from the existing cache
-	reverseCache := c.buildReverseCache(spaceID)
+	reverseCache := buildReverseCache(names)
 	if typeKey, found := reverseCache[typeName]; found {
 		return typeKey, nil
 	}
 
-	// If not in cache, fetch all types and update cache
-	types, err := c.GetTypes(ctx, &GetTypesParams{SpaceID: spaceID})
-	if err != nil {
-		return "", err
+	// If not found in previously cached data, the type may be new: fetch fresh types
+	if !fetched {
+		types, err := c.GetTypes(ctx, &GetTypesParams{SpaceID: spaceID})
+		if err != nil {
+			return "", err
+		}
+		names = typeNamesByKey(types.Data)
 	}
 
-	// Update cache with fresh data
-	reverseCache = c.updateTypeCaches(spaceID, types.Data, typeName)
+	// Build the lookup including special key matches
+	reverseCache = c.buildTypeLookup(names, typeName)
 
 	// Try different matching strategies in order
-	return c.findTypeKeyWithStrategies(ctx, spaceID, typeName, types.Data, reverseCache)
+	return c.findTypeKeyWithStrategies(typeName, names, reverseCache)
 }
 
-// initializeTypeCache initializes the type cache for a specific space
-func (c *Client) initializeTypeCache(spaceID string) {
-	if _, ok := c.typeCache[spaceID]; !ok {
-		c.typeCache[spaceID] = make(map[string]string)
+// buildReverseCache builds a reverse lookup (name -> key) from cached type names
+func buildReverseCache(names map[string]string) map[string]string {
+	reverseCache := make(map[string]string, len(names))
+	for key, name := range names {
+		reverseCache[name] = key
 	}
-}
-
-// buildReverseCache builds a reverse lookup (name -> key) from existing cache
-func (c *Client) buildReverseCache(spaceID string) map[string]string {
-	reverseCache := make(map[string]string)
-
-	if cache, ok := c.typeCache[spaceID]; ok && len(cache) > 0 {
-		for key, name := range cache {
-			reverseCache[name] = key
-		}
-	}
-
 	return reverseCache
 }
 
-// updateTypeCaches updates both the regular and reverse caches with type data
-func (c *Client) updateTypeCaches(spaceID string, types []TypeInfo, typeName string) map[string]string {
-	reverseCache := make(map[string]string)
+// buildTypeLookup builds a reverse lookup (name -> key) that also maps typeName
+// to keys with a matching "ot-" prefix
+func (c *Client) buildTypeLookup(names map[string]string, typeName string) map[string]string {
+	reverseCache := make(map[string]string, len(names))
 
-	for _, t := range types {
-		c.typeCache[spaceID][t.Key] = t.Name
-		reverseCache[t.Name] = t.Key
+	for key, name := range names {
+		reverseCache[name] = key
 
 		// Handle special case: "Page" -> "ot-page", "Note" -> "ot-note" etc.
-		if c.isOtPrefixMatch(t.Key, typeName) {
-			reverseCache[typeName] = t.Key
+		if c.isOtPrefixMatch(key, typeName) {
+			reverseCache[typeName] = key
 		}
 	}
 
@@ -290,8 +286,8 @@ func (c *Client) isOtPrefixMatch(key, typeName string) bool {
 }
 
 // findTypeKeyWithStrategies tries different strategies to find a type key
-func (c *Client) findTypeKeyWithStrategies(ctx context.Context, spaceID, typeName string,
-	types []TypeInfo, reverseCache map[string]string) (string, error) {
+func (c *Client) findTypeKeyWithStrategies(typeName string, names map[string]string,
+	reverseCache map[string]string) (string, error) {
 
 	// Strategy 1: Exact match from updated cache
 	if typeKey, found := reverseCache[typeName]; found {
@@ -305,7 +301,7 @@ func (c *Client) findTypeKeyWithStrategies(ctx context.Context, spaceID, typeNam
 	}
 
 	// Strategy 3: Standard key construction (e.g., "Page" -> "ot-page")
-	typeKey = c.findTypeKeyByStandardConstruction(typeName, types)
+	typeKey = c.findTypeKeyByStandardConstruction(typeName, names)
 	if typeKey != "" {
 		return typeKey, nil
 	}
@@ -327,15 +323,13 @@ func (c *Client) findTypeKeyCaseInsensitive(typeName string, reverseCache map[st
 }
 
 // findTypeKeyByStandardConstruction tries to construct a standard key format
-func (c *Client) findTypeKeyByStandardConstruction(typeName string, types []TypeInfo) string {
+func (c *Client) findTypeKeyByStandardConstruction(typeName string, names map[string]string) string {
 	standardKey := "ot-" + strings.ToLower(typeName)
-	for _, t := range types {
-		if t.Key == standardKey {
-			if c.debug && c.logger != nil {
-				c.logger.Debug("Found type using standard key construction: '%s' -> '%s'", typeName, standardKey)
-			}
-			return standardKey
+	if _, ok := names[standardKey]; ok {
+		if c.debug && c.logger != nil {
+			c.logger.Debug("Found type using standard key construction: '%s' -> '%s'", typeName, standardKey)
 		}
+		return standardKey
 	}
 	return ""
 }
@@ -409,6 +403,7 @@ func (c *Client) GetObject(ctx context.Context, params *GetObjectParams) (*Objec
 	}
 
 	path := fmt.Sprintf("/v1/spaces/%s/objects/%s", params.SpaceID, params.ObjectID)
+	ctx = withOperation(ctx, "GetObject", "/v1/spaces/{space_id}/objects/{object_id}")
 	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get object %s: %w", params.ObjectID, err)
@@ -468,25 +463,14 @@ func (c *Client) CreateObject(ctx context.Context, spaceID string, object *Objec
 	if err := object.Validate(); err != nil {
 		return nil, err
 	}
+	return c.createObject(ctx, spaceID, object)
+}
 
+// createObject sends a request creating an object, without validating it
+func (c *Client) createObject(ctx context.Context, spaceID string, object *Object) (*Object, error) {
 	// Ensure we add tags to Relations if they're specified in the Tags field
-	if len(object.Tags) > 0 {
-		if object.Relations == nil {
-			object.Relations = &Relations{
-				Items: make(map[string][]Relation),
-			}
-		}
-
-		// Create tag relations from tag names
-		tagRelations := make([]Relation, 0, len(object.Tags))
-		for _, tagName := range object.Tags {
-			tagRelations = append(tagRelations, Relation{
-				Name: tagName,
-			})
-		}
-
-		// Add to the relations map
-		object.Relations.Items["tags"] = tagRelations
+	if err := c.setTagRelations(ctx, spaceID, object); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1/spaces/%s/objects", spaceID)
@@ -495,6 +479,7 @@ func (c *Client) CreateObject(ctx context.Context, spaceID string, object *Objec
 		return nil, fmt.Errorf("failed to marshal object: %w", err)
 	}
 
+	ctx = withOperation(ctx, "CreateObject", "/v1/spaces/{space_id}/objects")
 	data, err := c.makeRequest(ctx, http.MethodPost, path, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create object: %w", err)
@@ -538,6 +523,7 @@ func (c *Client) DeleteObject(ctx context.Context, spaceID, objectID string) err
 	}
 
 	path := fmt.Sprintf("/v1/spaces/%s/objects/%s", spaceID, objectID)
+	ctx = withOperation(ctx, "DeleteObject", "/v1/spaces/{space_id}/objects/{object_id}")
 	_, err := c.makeRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete object %s: %w", objectID, err)
@@ -556,6 +542,9 @@ func (c *Client) DeleteObject(ctx context.Context, spaceID, objectID string) err
 // If the object contains tags in the Tags field, they will automatically be
 // added to the object's Relations, replacing any existing tag relations.
 //
+// Empty fields are not sent, so they can't be cleared with UpdateObject;
+// use PatchObject to clear values or to change only some properties and tags.
+//
 // Example:
 //
 //	// Update an existing object
@@ -589,23 +578,8 @@ func (c *Client) UpdateObject(ctx context.Context, spaceID, objectID string, obj
 	object.ID = objectID
 
 	// Ensure we add tags to Relations if they're specified in the Tags field
-	if len(object.Tags) > 0 {
-		if object.Relations == nil {
-			object.Relations = &Relations{
-				Items: make(map[string][]Relation),
-			}
-		}
-
-		// Create tag relations from tag names
-		tagRelations := make([]Relation, 0, len(object.Tags))
-		for _, tagName := range object.Tags {
-			tagRelations = append(tagRelations, Relation{
-				Name: tagName,
-			})
-		}
-
-		// Add to the relations map
-		object.Relations.Items["tags"] = tagRelations
+	if err := c.setTagRelations(ctx, spaceID, object); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/v1/spaces/%s/objects/%s", spaceID, objectID)
@@ -614,6 +588,7 @@ func (c *Client) UpdateObject(ctx context.Context, spaceID, objectID string, obj
 		return nil, fmt.Errorf("failed to marshal object: %w", err)
 	}
 
+	ctx = withOperation(ctx, "UpdateObject", "/v1/spaces/{space_id}/objects/{object_id}")
 	data, err := c.makeRequest(ctx, http.MethodPut, path, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to update object %s: %w", objectID, err)
@@ -635,16 +610,27 @@ func (c *Client) UpdateObject(ctx context.Context, spaceID, objectID string, obj
 	return &objectResponse.Object, nil
 }
 
-// GetMembers retrieves members of a space
+// GetMembers retrieves all members of a space, walking through all result pages
 func (c *Client) GetMembers(ctx context.Context, spaceID string) (*MembersResponse, error) {
 	if spaceID == "" {
 		return nil, wrapError("/v1/spaces/{id}/members", 0, "space ID is required", ErrInvalidSpaceID)
 	}
 
-	path := fmt.Sprintf("/v1/spaces/%s/members", spaceID)
+	members, err := c.MembersPager(spaceID).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MembersResponse{Data: members, Pagination: completePagination(len(members))}, nil
+}
+
+// getMembersPage retrieves a single page of members of a space
+func (c *Client) getMembersPage(ctx context.Context, spaceID string, offset, limit int) ([]Member, Pagination, error) {
+	path := fmt.Sprintf("/v1/spaces/%s/members?offset=%d&limit=%d", spaceID, offset, limit)
+	ctx = withOperation(ctx, "GetMembers", "/v1/spaces/{space_id}/members")
 	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, wrapError(path, 0, fmt.Sprintf("failed to get members for space %s", spaceID), err)
+		return nil, Pagination{}, wrapError(path, 0, fmt.Sprintf("failed to get members for space %s", spaceID), err)
 	}
 
 	if c.debug && c.logger != nil {
@@ -653,8 +639,8 @@ func (c *Client) GetMembers(ctx context.Context, spaceID string) (*MembersRespon
 
 	var response MembersResponse
 	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, wrapError(path, 0, "failed to parse members response", err)
+		return nil, Pagination{}, wrapError(path, 0, "failed to parse members response", err)
 	}
 
-	return &response, nil
+	return response.Data, response.Pagination, nil
 }
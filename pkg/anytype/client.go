@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/epheo/anytype-go/internal/log"
@@ -40,15 +41,29 @@ type ClientOption func(*Client)
 // Client provides methods to interact with spaces, objects, types, and other
 // Anytype resources. It handles authentication, request formatting, and response
 // parsing to provide a seamless interface to the Anytype API.
+//
+// A Client is safe for concurrent use by multiple goroutines once created.
+// Options must only be applied through NewClient.
 type Client struct {
-	apiURL       string                       // The base URL for API requests
-	sessionToken string                       // Authentication session token
-	appKey       string                       // Application key for authentication
-	httpClient   *http.Client                 // HTTP client for making requests
-	debug        bool                         // Whether debug logging is enabled
-	printCurl    bool                         // Whether to print curl commands
-	typeCache    map[string]map[string]string // Cache mapping spaceID -> typeKey -> typeName
-	logger       log.Logger                   // Logger for output
+	apiURL            string            // The base URL for API requests
+	sessionToken      string            // Authentication session token
+	appKey            string            // Application key for authentication
+	httpClient        *http.Client      // HTTP client for making requests
+	debug             bool              // Whether debug logging is enabled
+	printCurl         bool              // Whether to print curl commands
+	typeCache         *typeCache        // Cache mapping spaceID -> typeKey -> typeName
+	logger            log.Logger        // Logger for output
+	retryPolicy       RetryPolicy       // Policy for retrying failed requests
+	rateLimiter       *rateLimiter      // Limits the request rate, nil if unlimited
+	requestSlots      chan struct{}     // Limits concurrent requests, nil if unlimited
+	transport         http.RoundTripper // Custom transport for the HTTP client, nil for default
+	middlewares       []Middleware      // Custom middlewares wrapping each request
+	roundTrip         RoundTripFunc     // Request chain built from the middlewares
+	hooks             []Hook            // Hooks notified about every operation
+	serverFilters     bool              // Whether to send tag filters to the API
+	resolveTags       bool              // Whether object writes refer to tags by ID
+	createMissingTags bool              // Whether tags missing when resolving tags are created
+	noGlobalSearch    atomic.Bool       // Set once the global search endpoint was found missing
 }
 
 // WithTimeout sets a custom timeout for the HTTP client.
@@ -213,7 +228,7 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		apiURL:     defaultAPIURL, // Default API URL
 		httpClient: &http.Client{Timeout: httpTimeout},
 		debug:      false,
-		typeCache:  make(map[string]map[string]string),
+		typeCache:  newTypeCache(),
 	}
 
 	// Apply options
@@ -230,6 +245,9 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		return nil, fmt.Errorf("app key is required")
 	}
 
+	// Assemble the request chain once all options are known
+	client.roundTrip = client.buildRoundTrip()
+
 	return client, nil
 }
 
@@ -284,12 +302,90 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return value
 }
 
-// makeRequest is a helper function to make HTTP requests
+// makeRequest is a helper function to make HTTP requests.
+//
+// Failed requests are retried according to the client's retry policy. The
+// returned *Error reports how many attempts were made. Hooks are notified
+// once per call, covering all attempts.
 func (c *Client) makeRequest(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	// Buffer the body so it can be replayed on retries
+	var bodyData []byte
+	if body != nil {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, WrapError(path, 0, "failed to read request body", err)
+		}
+		bodyData = data
+	}
+
+	ctx, endOperation := c.startOperation(ctx, method, path, len(bodyData))
+	responseData, statusCode, attempts, err := c.doRequestWithRetries(ctx, method, path, bodyData)
+	endOperation(statusCode, len(responseData), attempts, err)
+
+	return responseData, err
+}
+
+// doRequestWithRetries performs a request, retrying it according to the retry policy.
+//
+// It returns the response body, the status code of the last attempt and the number of attempts made.
+func (c *Client) doRequestWithRetries(ctx context.Context, method, path string, body []byte) ([]byte, int, int, error) {
+	maxAttempts := c.retryPolicy.maxAttempts()
+	for attempt := 1; ; attempt++ {
+		release, err := c.acquireRequestSlot(ctx, path)
+		if err != nil {
+			return nil, 0, attempt - 1, err
+		}
+
+		responseData, retryAfter, hasRetryAfter, err := c.doRequest(ctx, method, path, body)
+		release()
+		if err == nil {
+			return responseData, http.StatusOK, attempt, nil
+		}
+
+		statusCode := 0
+		var apiErr *Error
+		if errors.As(err, &apiErr) {
+			apiErr.Attempts = attempt
+			statusCode = apiErr.StatusCode
+		}
+
+		if attempt >= maxAttempts || !c.retryPolicy.shouldRetry(ctx, method, err) {
+			return nil, statusCode, attempt, err
+		}
+
+		delay := c.retryPolicy.backoff(attempt)
+		if hasRetryAfter {
+			delay = retryAfter
+		}
+
+		if c.debug && c.logger != nil {
+			c.logger.Debug("Retrying %s %s in %v (attempt %d/%d): %v", method, path, delay, attempt+1, maxAttempts, err)
+		}
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			// Report the context error, with the error of the last attempt as details
+			retryErr := WrapErrorWithDetails(path, 0, "retry canceled", err.Error(), sleepErr)
+			retryErr.Attempts = attempt
+			return nil, statusCode, attempt, retryErr
+		}
+	}
+}
+
+// doRequest performs a single HTTP request attempt.
+//
+// Besides the response body it returns the delay advertised by the server
+// in a Retry-After header on 429 and 503 responses, and whether there was one.
+func (c *Client) doRequest(ctx context.Context, method, path string, body []byte) ([]byte, time.Duration, bool, error) {
 	url := c.apiURL + path
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
-		return nil, WrapError(path, 0, "failed to create HTTP request", err)
+		return nil, 0, false, WrapError(path, 0, "failed to create HTTP request", err)
 	}
 
 	// Set standard headers
@@ -297,50 +393,36 @@ func (c *Client) makeRequest(ctx context.Context, method, path string, body io.R
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.appKey))
 	req.Header.Set("Anytype-Version", apiVersion)
 
-	// Print curl command if debug mode or curl mode is enabled
-	if c.debug || c.printCurl {
-		c.printCurlRequest(method, url, req.Header, bodyToBytes(body))
-	}
-
-	resp, err := c.httpClient.Do(req)
+	// Send the request through the middleware chain
+	resp, err := c.roundTrip(req)
 	if err != nil {
 		// Check if context was canceled
 		if errors.Is(err, context.Canceled) {
-			return nil, WrapError(path, 0, "request canceled", err)
+			return nil, 0, false, WrapError(path, 0, "request canceled", err)
 		}
 		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, WrapError(path, 0, "request timed out", ErrOperationTimeout)
+			return nil, 0, false, WrapError(path, 0, "request timed out", ErrOperationTimeout)
 		}
-		return nil, WrapError(path, 0, "failed to execute HTTP request", fmt.Errorf("%w: %s", ErrNetworkError, err.Error()))
+		return nil, 0, false, WrapError(path, 0, "failed to execute HTTP request", fmt.Errorf("%w: %s", ErrNetworkError, err.Error()))
 	}
 	defer resp.Body.Close()
 
 	responseData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, WrapError(path, resp.StatusCode, "failed to read response body", err)
-	}
-
-	if c.debug && c.logger != nil {
-		c.logger.Debug("Response: %s", string(responseData))
+		return nil, 0, false, WrapError(path, resp.StatusCode, "failed to read response body", fmt.Errorf("%w: %s", ErrNetworkError, err.Error()))
 	}
 
 	if resp.StatusCode != http.StatusOK {
+		var retryAfter time.Duration
+		var hasRetryAfter bool
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			retryAfter, hasRetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
 		baseError := StatusCodeToError(resp.StatusCode)
-		return nil, extractErrorFromResponse(path, resp.StatusCode, responseData, baseError)
+		return nil, retryAfter, hasRetryAfter, extractErrorFromResponse(path, resp.StatusCode, responseData, baseError)
 	}
 
-	return responseData, nil
-}
-
-// bodyToBytes converts an io.Reader to bytes for debug printing
-func bodyToBytes(body io.Reader) []byte {
-	if body == nil {
-		return nil
-	}
-	if bodyBytes, ok := body.(*bytes.Buffer); ok {
-		return bodyBytes.Bytes()
-	}
-	return nil
+	return responseData, 0, false, nil
 }
 
 // extractErrorFromResponse tries to extract a meaningful error message from API response
@@ -408,35 +490,14 @@ func (c *Client) printCurlRequest(method, url string, headers http.Header, body
 
 // GetTypeName returns the friendly name for a type key, using cache if available
 func (c *Client) GetTypeName(ctx context.Context, spaceID, typeKey string) string {
-	// Check cache first
-	if cache, ok := c.typeCache[spaceID]; ok {
-		if name, ok := cache[typeKey]; ok {
-			return name
-		}
-	}
-
-	// Initialize cache for this space if needed
-	if _, ok := c.typeCache[spaceID]; !ok {
-		c.typeCache[spaceID] = make(map[string]string)
-	}
-
-	// If cache is empty for this space, fetch all types at once
-	// instead of doing it for each type key separately
-	if len(c.typeCache[spaceID]) == 0 {
-		// Fetch all types and update cache
-		types, err := c.GetTypes(ctx, &GetTypesParams{SpaceID: spaceID})
-		if err != nil {
-			return typeKey // Return original key if error
-		}
-
-		// Update cache with all types
-		for _, t := range types.Data {
-			c.typeCache[spaceID][t.Key] = t.Name
-		}
+	// Fetch all types of the space at once instead of doing it for each type key separately
+	names, _, err := c.cachedTypeNames(ctx, spaceID)
+	if err != nil {
+		return typeKey // Return original key if error
 	}
 
 	// Return cached value or original key if not found
-	if name, ok := c.typeCache[spaceID][typeKey]; ok {
+	if name, ok := names[typeKey]; ok {
 		return name
 	}
 	return typeKey
@@ -28,6 +28,7 @@ var (
 	ErrObjectNotFound     = errors.New("object not found")
 	ErrTypeNotFound       = errors.New("type not found")
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrRateLimited        = errors.New("rate limited")
 )
 
 // Error wraps API errors with additional context
@@ -37,10 +38,20 @@ type Error struct {
 	Path       string // API path that caused the error
 	Err        error  // Underlying error for unwrapping
 	Details    string // Additional error details
+	Attempts   int    // Number of attempts made, including retries
 }
 
 // Implements the error interface
 func (e *Error) Error() string {
+	msg := e.message()
+	if e.Attempts > 1 {
+		msg += fmt.Sprintf(" (after %d attempts)", e.Attempts)
+	}
+	return msg
+}
+
+// message formats the error without retry information
+func (e *Error) message() string {
 	if e.StatusCode != 0 {
 		if e.Details != "" {
 			return fmt.Sprintf("API error: %s (status %d) - %s - %s", e.Path, e.StatusCode, e.Message, e.Details)
@@ -66,6 +77,7 @@ func wrapError(path string, statusCode int, message string, err error) *Error {
 		Message:    message,
 		Path:       path,
 		Err:        err,
+		Attempts:   attemptsFromError(err),
 	}
 }
 
@@ -76,6 +88,7 @@ func WrapError(path string, statusCode int, message string, err error) *Error {
 		Message:    message,
 		Path:       path,
 		Err:        err,
+		Attempts:   attemptsFromError(err),
 	}
 }
 
@@ -87,7 +100,17 @@ func WrapErrorWithDetails(path string, statusCode int, message string, details s
 		Path:       path,
 		Err:        err,
 		Details:    details,
+		Attempts:   attemptsFromError(err),
+	}
+}
+
+// attemptsFromError returns the attempt count recorded on a wrapped *Error
+func attemptsFromError(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Attempts
 	}
+	return 0
 }
 
 // StatusCodeToError maps HTTP status codes to appropriate error types
@@ -103,12 +126,14 @@ func StatusCodeToError(statusCode int) error {
 		return ErrServerError
 	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
 		return ErrOperationTimeout
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
 	default:
 		if statusCode >= 400 && statusCode < 500 {
 			return fmt.Errorf("client error: status code %d", statusCode)
 		}
 		if statusCode >= 500 {
-			return fmt.Errorf("server error: status code %d", statusCode)
+			return fmt.Errorf("%w: status code %d", ErrServerError, statusCode)
 		}
 	}
 	return nil
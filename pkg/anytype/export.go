@@ -188,6 +188,7 @@ func (c *Client) getObjectContent(ctx context.Context, spaceID, objectID, format
 	path := fmt.Sprintf("/v1/spaces/%s/objects/%s/%s", spaceID, objectID, format)
 
 	// Make API request
+	ctx = withOperation(ctx, "ExportObject", "/v1/spaces/{space_id}/objects/{object_id}/{format}")
 	data, err := c.makeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		// If the export endpoint returned a 404, try to extract content from the regular object GET endpoint
@@ -0,0 +1,358 @@
+package anytype
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FilterOperator combines the conditions of a FilterExpression
+type FilterOperator string
+
+// Filter operators supported by the search endpoint
+const (
+	FilterOperatorAnd FilterOperator = "and"
+	FilterOperatorOr  FilterOperator = "or"
+)
+
+// FilterConditionType is the comparison applied by a FilterCondition
+type FilterConditionType string
+
+// Filter conditions supported by the search endpoint
+const (
+	FilterConditionEqual          FilterConditionType = "eq"
+	FilterConditionNotEqual       FilterConditionType = "ne"
+	FilterConditionGreater        FilterConditionType = "gt"
+	FilterConditionGreaterOrEqual FilterConditionType = "gte"
+	FilterConditionLess           FilterConditionType = "lt"
+	FilterConditionLessOrEqual    FilterConditionType = "lte"
+	FilterConditionContains       FilterConditionType = "contains"
+	FilterConditionNotContains    FilterConditionType = "ncontains"
+	FilterConditionIn             FilterConditionType = "in"
+	FilterConditionNotIn          FilterConditionType = "nin"
+	FilterConditionAll            FilterConditionType = "all"
+	FilterConditionEmpty          FilterConditionType = "empty"
+	FilterConditionNotEmpty       FilterConditionType = "nempty"
+)
+
+// FilterCondition compares a single property of objects with a value.
+//
+// The value is serialized under the key of the property format, e.g.
+// {"property_key": "tag", "condition": "in", "multi_select": ["important"]},
+// which is how the search endpoint expects property filters.
+type FilterCondition struct {
+	PropertyKey string              // Key of the property to compare, e.g. "tag" or "due_date"
+	Format      PropertyFormat      // Property format, e.g. "multi_select", "date" or "number"
+	Condition   FilterConditionType // Comparison to apply
+	Value       interface{}         // Value to compare with, unused for empty checks
+}
+
+// MarshalJSON implements json.Marshaler
+func (fc FilterCondition) MarshalJSON() ([]byte, error) {
+	fields := map[string]interface{}{
+		"property_key": fc.PropertyKey,
+		"condition":    fc.Condition,
+	}
+	if fc.Format != "" && fc.Value != nil {
+		fields[string(fc.Format)] = fc.Value
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (fc *FilterCondition) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*fc = FilterCondition{}
+	for key, raw := range fields {
+		var err error
+		switch key {
+		case "property_key":
+			err = json.Unmarshal(raw, &fc.PropertyKey)
+		case "condition":
+			err = json.Unmarshal(raw, &fc.Condition)
+		default:
+			// Any other key carries the value under the property format
+			fc.Format = PropertyFormat(key)
+			err = json.Unmarshal(raw, &fc.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid filter field %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// FilterExpression combines conditions and nested expressions with an operator.
+//
+// Filter expressions are plain data and can be serialized to JSON. They are
+// usually built with the typed property helpers and combined with And, Or and Not.
+//
+// Example:
+//
+//	// Objects tagged "important" or "urgent"
+//	filter := &anytype.FilterExpression{
+//	    Operator: anytype.FilterOperatorOr,
+//	    Conditions: []anytype.FilterCondition{
+//	        {PropertyKey: "tag", Format: anytype.PropertyFormatMultiSelect, Condition: anytype.FilterConditionIn, Value: []string{"important", "urgent"}},
+//	    },
+//	}
+type FilterExpression struct {
+	Operator   FilterOperator     `json:"operator,omitempty"`   // Operator combining conditions and filters, "and" by default
+	Conditions []FilterCondition  `json:"conditions,omitempty"` // Conditions on properties
+	Filters    []FilterExpression `json:"filters,omitempty"`    // Nested expressions
+	Not        bool               `json:"not,omitempty"`        // Whether the expression is negated
+}
+
+// IsEmpty reports whether the expression contains no conditions
+func (fe *FilterExpression) IsEmpty() bool {
+	if fe == nil {
+		return true
+	}
+	for i := range fe.Filters {
+		if !fe.Filters[i].IsEmpty() {
+			return false
+		}
+	}
+	return len(fe.Conditions) == 0
+}
+
+// condition returns an expression made of a single condition
+func condition(key string, format PropertyFormat, cond FilterConditionType, value interface{}) FilterExpression {
+	return FilterExpression{
+		Conditions: []FilterCondition{{PropertyKey: key, Format: format, Condition: cond, Value: value}},
+	}
+}
+
+// And returns an expression matching objects that match all filters
+func And(filters ...FilterExpression) FilterExpression {
+	return FilterExpression{Operator: FilterOperatorAnd, Filters: filters}
+}
+
+// Or returns an expression matching objects that match any of the filters
+func Or(filters ...FilterExpression) FilterExpression {
+	return FilterExpression{Operator: FilterOperatorOr, Filters: filters}
+}
+
+// Not returns an expression matching objects that don't match the filter
+func Not(filter FilterExpression) FilterExpression {
+	filter.Not = !filter.Not
+	return filter
+}
+
+// DateFilter builds conditions on a date property.
+//
+// Example:
+//
+//	overdue := anytype.DateProperty("due_date").Before(time.Now())
+type DateFilter struct {
+	key string
+}
+
+// DateProperty returns a filter builder for the date property with the given key
+func DateProperty(key string) DateFilter {
+	return DateFilter{key: key}
+}
+
+// Before matches dates strictly before t
+func (f DateFilter) Before(t time.Time) FilterExpression {
+	return condition(f.key, PropertyFormatDate, FilterConditionLess, t)
+}
+
+// After matches dates strictly after t
+func (f DateFilter) After(t time.Time) FilterExpression {
+	return condition(f.key, PropertyFormatDate, FilterConditionGreater, t)
+}
+
+// On matches dates equal to t
+func (f DateFilter) On(t time.Time) FilterExpression {
+	return condition(f.key, PropertyFormatDate, FilterConditionEqual, t)
+}
+
+// IsSet matches objects where the date is set
+func (f DateFilter) IsSet() FilterExpression {
+	return condition(f.key, PropertyFormatDate, FilterConditionNotEmpty, nil)
+}
+
+// IsNotSet matches objects where the date is not set
+func (f DateFilter) IsNotSet() FilterExpression {
+	return condition(f.key, PropertyFormatDate, FilterConditionEmpty, nil)
+}
+
+// NumberFilter builds conditions on a number property.
+//
+// Example:
+//
+//	important := anytype.NumberProperty("priority").GreaterThan(2)
+type NumberFilter struct {
+	key string
+}
+
+// NumberProperty returns a filter builder for the number property with the given key
+func NumberProperty(key string) NumberFilter {
+	return NumberFilter{key: key}
+}
+
+// Equals matches numbers equal to n
+func (f NumberFilter) Equals(n float64) FilterExpression {
+	return condition(f.key, PropertyFormatNumber, FilterConditionEqual, n)
+}
+
+// NotEquals matches numbers different from n
+func (f NumberFilter) NotEquals(n float64) FilterExpression {
+	return condition(f.key, PropertyFormatNumber, FilterConditionNotEqual, n)
+}
+
+// GreaterThan matches numbers strictly greater than n
+func (f NumberFilter) GreaterThan(n float64) FilterExpression {
+	return condition(f.key, PropertyFormatNumber, FilterConditionGreater, n)
+}
+
+// GreaterThanOrEqual matches numbers greater than or equal to n
+func (f NumberFilter) GreaterThanOrEqual(n float64) FilterExpression {
+	return condition(f.key, PropertyFormatNumber, FilterConditionGreaterOrEqual, n)
+}
+
+// LessThan matches numbers strictly less than n
+func (f NumberFilter) LessThan(n float64) FilterExpression {
+	return condition(f.key, PropertyFormatNumber, FilterConditionLess, n)
+}
+
+// LessThanOrEqual matches numbers less than or equal to n
+func (f NumberFilter) LessThanOrEqual(n float64) FilterExpression {
+	return condition(f.key, PropertyFormatNumber, FilterConditionLessOrEqual, n)
+}
+
+// CheckboxFilter builds conditions on a checkbox property
+type CheckboxFilter struct {
+	key string
+}
+
+// CheckboxProperty returns a filter builder for the checkbox property with the given key
+func CheckboxProperty(key string) CheckboxFilter {
+	return CheckboxFilter{key: key}
+}
+
+// IsTrue matches checked checkboxes
+func (f CheckboxFilter) IsTrue() FilterExpression {
+	return condition(f.key, PropertyFormatCheckbox, FilterConditionEqual, true)
+}
+
+// IsFalse matches unchecked checkboxes, including unset ones
+func (f CheckboxFilter) IsFalse() FilterExpression {
+	return condition(f.key, PropertyFormatCheckbox, FilterConditionEqual, false)
+}
+
+// SelectFilter builds conditions on a select or multi-select property.
+//
+// Values are matched against option names (case-insensitively) or option IDs.
+type SelectFilter struct {
+	key    string
+	format PropertyFormat
+}
+
+// SelectProperty returns a filter builder for the select property with the given key
+func SelectProperty(key string) SelectFilter {
+	return SelectFilter{key: key, format: PropertyFormatSelect}
+}
+
+// MultiSelectProperty returns a filter builder for the multi-select property with the given key
+func MultiSelectProperty(key string) SelectFilter {
+	return SelectFilter{key: key, format: PropertyFormatMultiSelect}
+}
+
+// In matches objects having any of the values
+func (f SelectFilter) In(values ...string) FilterExpression {
+	return condition(f.key, f.format, FilterConditionIn, values)
+}
+
+// NotIn matches objects having none of the values
+func (f SelectFilter) NotIn(values ...string) FilterExpression {
+	return condition(f.key, f.format, FilterConditionNotIn, values)
+}
+
+// All matches objects having all of the values. It is only meaningful for multi-select properties.
+func (f SelectFilter) All(values ...string) FilterExpression {
+	return condition(f.key, f.format, FilterConditionAll, values)
+}
+
+// IsSet matches objects where at least one value is selected
+func (f SelectFilter) IsSet() FilterExpression {
+	return condition(f.key, f.format, FilterConditionNotEmpty, nil)
+}
+
+// IsNotSet matches objects where no value is selected
+func (f SelectFilter) IsNotSet() FilterExpression {
+	return condition(f.key, f.format, FilterConditionEmpty, nil)
+}
+
+// TextFilter builds conditions on a text, URL, email or phone property
+type TextFilter struct {
+	key    string
+	format PropertyFormat
+}
+
+// TextProperty returns a filter builder for the text property with the given key
+func TextProperty(key string) TextFilter {
+	return TextFilter{key: key, format: PropertyFormatText}
+}
+
+// URLProperty returns a filter builder for the URL property with the given key
+func URLProperty(key string) TextFilter {
+	return TextFilter{key: key, format: PropertyFormatURL}
+}
+
+// EmailProperty returns a filter builder for the email property with the given key
+func EmailProperty(key string) TextFilter {
+	return TextFilter{key: key, format: PropertyFormatEmail}
+}
+
+// Equals matches values equal to s, ignoring case
+func (f TextFilter) Equals(s string) FilterExpression {
+	return condition(f.key, f.format, FilterConditionEqual, s)
+}
+
+// Contains matches values containing s, ignoring case
+func (f TextFilter) Contains(s string) FilterExpression {
+	return condition(f.key, f.format, FilterConditionContains, s)
+}
+
+// NotContains matches values not containing s, ignoring case
+func (f TextFilter) NotContains(s string) FilterExpression {
+	return condition(f.key, f.format, FilterConditionNotContains, s)
+}
+
+// IsSet matches objects where the value is not empty
+func (f TextFilter) IsSet() FilterExpression {
+	return condition(f.key, f.format, FilterConditionNotEmpty, nil)
+}
+
+// IsNotSet matches objects where the value is empty
+func (f TextFilter) IsNotSet() FilterExpression {
+	return condition(f.key, f.format, FilterConditionEmpty, nil)
+}
+
+// WithServerSideFilters makes searches send tag and property filters to the API.
+//
+// By default, filtering is performed client-side: the client pages through
+// the search results, keeps matching objects and applies Offset and Limit to the
+// filtered results. When the API supports property filters, enabling this option
+// lets the server filter and paginate the results instead, which needs far fewer requests.
+// Negated expressions are rewritten for the server when possible and evaluated
+// client-side otherwise.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithServerSideFilters(true),
+//	)
+func WithServerSideFilters(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.serverFilters = enabled
+	}
+}
@@ -0,0 +1,414 @@
+package anytype
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Matches evaluates the expression against an object client-side.
+//
+// Properties are looked up by key, falling back to a case-insensitive name match.
+// The "name" key matches the object name and the "tag" key also matches the
+// tags extracted into Object.Tags. An empty
+// expression matches every object.
+func (fe *FilterExpression) Matches(obj Object) bool {
+	if fe == nil {
+		return true
+	}
+
+	or := fe.Operator == FilterOperatorOr
+	result := !or
+	hasTerms := false
+	evaluate := func(match bool) {
+		hasTerms = true
+		if or {
+			result = result || match
+		} else {
+			result = result && match
+		}
+	}
+
+	for _, fc := range fe.Conditions {
+		evaluate(fc.matches(obj))
+	}
+	for i := range fe.Filters {
+		evaluate(fe.Filters[i].Matches(obj))
+	}
+
+	if !hasTerms {
+		result = true
+	}
+	if fe.Not {
+		return !result
+	}
+	return result
+}
+
+// inverseConditions maps conditions to their negation
+var inverseConditions = map[FilterConditionType]FilterConditionType{
+	FilterConditionEqual:          FilterConditionNotEqual,
+	FilterConditionNotEqual:       FilterConditionEqual,
+	FilterConditionGreater:        FilterConditionLessOrEqual,
+	FilterConditionLessOrEqual:    FilterConditionGreater,
+	FilterConditionGreaterOrEqual: FilterConditionLess,
+	FilterConditionLess:           FilterConditionGreaterOrEqual,
+	FilterConditionContains:       FilterConditionNotContains,
+	FilterConditionNotContains:    FilterConditionContains,
+	FilterConditionIn:             FilterConditionNotIn,
+	FilterConditionNotIn:          FilterConditionIn,
+	FilterConditionEmpty:          FilterConditionNotEmpty,
+	FilterConditionNotEmpty:       FilterConditionEmpty,
+}
+
+// orderedConditions are the comparisons that never match objects missing the property
+var orderedConditions = map[FilterConditionType]bool{
+	FilterConditionGreater:        true,
+	FilterConditionGreaterOrEqual: true,
+	FilterConditionLess:           true,
+	FilterConditionLessOrEqual:    true,
+}
+
+// withoutNegation rewrites the expression without Not flags so it can be sent to the API.
+//
+// Negations are pushed down to the conditions using De Morgan's laws. As
+// objects missing the property match a negated ordered comparison, NOT (n > 5)
+// is rewritten to n <= 5 OR n is empty. It returns false if a condition has
+// no negation the API understands, such as "all".
+func (fe FilterExpression) withoutNegation(negate bool) (FilterExpression, bool) {
+	negate = negate != fe.Not
+
+	out := FilterExpression{Operator: fe.Operator}
+	if negate {
+		if fe.Operator == FilterOperatorOr {
+			out.Operator = FilterOperatorAnd
+		} else {
+			out.Operator = FilterOperatorOr
+		}
+	}
+
+	for _, fc := range fe.Conditions {
+		if !negate {
+			out.Conditions = append(out.Conditions, fc)
+			continue
+		}
+
+		inverse, ok := inverseConditions[fc.Condition]
+		if !ok {
+			return FilterExpression{}, false
+		}
+		inverted, empty := fc, FilterCondition{PropertyKey: fc.PropertyKey, Format: fc.Format, Condition: FilterConditionEmpty}
+		inverted.Condition = inverse
+		negated := []FilterCondition{inverted}
+		if orderedConditions[fc.Condition] {
+			negated = append(negated, empty)
+		}
+
+		if len(negated) == 1 || out.Operator == FilterOperatorOr {
+			out.Conditions = append(out.Conditions, negated...)
+		} else {
+			out.Filters = append(out.Filters, FilterExpression{Operator: FilterOperatorOr, Conditions: negated})
+		}
+	}
+
+	for _, nested := range fe.Filters {
+		rewritten, ok := nested.withoutNegation(negate)
+		if !ok {
+			return FilterExpression{}, false
+		}
+		out.Filters = append(out.Filters, rewritten)
+	}
+
+	return out, true
+}
+
+// findProperty returns the property of an object with the given key or name
+func findProperty(obj Object, key string) (Property, bool) {
+	for _, prop := range obj.Properties {
+		if prop.Key == key {
+			return prop, true
+		}
+	}
+	for _, prop := range obj.Properties {
+		if prop.Key == "" && strings.EqualFold(prop.Name, key) {
+			return prop, true
+		}
+	}
+	if key == "name" {
+		return Property{Key: key, Format: string(PropertyFormatText), Text: obj.Name}, true
+	}
+	return Property{}, false
+}
+
+// matches evaluates the condition against an object
+func (fc FilterCondition) matches(obj Object) bool {
+	prop, found := findProperty(obj, fc.PropertyKey)
+
+	format := fc.Format
+	if found && prop.Format != "" {
+		format = PropertyFormat(prop.Format)
+	}
+
+	switch format {
+	case PropertyFormatNumber:
+		return compareNumber(fc.Condition, prop.Number, found, fc.Value)
+	case PropertyFormatDate:
+		return compareDate(fc.Condition, prop.Date, fc.Value)
+	case PropertyFormatCheckbox:
+		return compareCheckbox(fc.Condition, prop.Checkbox, fc.Value)
+	case PropertyFormatSelect, PropertyFormatMultiSelect, PropertyFormatObjects:
+		values := propertyOptionValues(prop)
+		if fc.PropertyKey == "tag" && !found {
+			values = append(append(values, obj.Tags...), objectTagIDs(obj)...)
+		}
+		return compareValues(fc.Condition, values, filterStrings(fc.Value))
+	default:
+		return compareText(fc.Condition, propertyText(prop), fc.Value)
+	}
+}
+
+// propertyOptionValues returns the names and IDs of the selected options or objects of a property
+func propertyOptionValues(prop Property) []string {
+	var values []string
+	if prop.Select != nil {
+		values = append(values, prop.Select.ID, prop.Select.Name)
+	}
+	for _, tag := range prop.MultiSelect {
+		values = append(values, tag.ID, tag.Name)
+	}
+	values = append(values, prop.Object...)
+
+	nonEmpty := values[:0]
+	for _, v := range values {
+		if v != "" {
+			nonEmpty = append(nonEmpty, v)
+		}
+	}
+	return nonEmpty
+}
+
+// propertyText returns the textual value of a property
+func propertyText(prop Property) string {
+	switch PropertyFormat(prop.Format) {
+	case PropertyFormatURL:
+		return prop.URL
+	case PropertyFormatEmail:
+		return prop.Email
+	case PropertyFormatPhone:
+		return prop.Phone
+	}
+	for _, value := range []string{prop.Text, prop.URL, prop.Email, prop.Phone} {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// compareNumber evaluates a condition on a number property
+func compareNumber(cond FilterConditionType, actual float64, present bool, value interface{}) bool {
+	switch cond {
+	case FilterConditionEmpty:
+		return !present
+	case FilterConditionNotEmpty:
+		return present
+	}
+
+	expected, ok := filterNumber(value)
+	if !ok {
+		return false
+	}
+	switch cond {
+	case FilterConditionEqual:
+		return present && actual == expected
+	case FilterConditionNotEqual:
+		return !present || actual != expected
+	case FilterConditionGreater:
+		return present && actual > expected
+	case FilterConditionGreaterOrEqual:
+		return present && actual >= expected
+	case FilterConditionLess:
+		return present && actual < expected
+	case FilterConditionLessOrEqual:
+		return present && actual <= expected
+	}
+	return false
+}
+
+// compareDate evaluates a condition on a date property
+func compareDate(cond FilterConditionType, actual string, value interface{}) bool {
+	date, present := parseFilterDate(actual)
+	switch cond {
+	case FilterConditionEmpty:
+		return !present
+	case FilterConditionNotEmpty:
+		return present
+	}
+
+	expected, ok := filterDate(value)
+	if !ok {
+		return false
+	}
+	if !present {
+		return cond == FilterConditionNotEqual
+	}
+	switch cond {
+	case FilterConditionEqual:
+		return date.Equal(expected)
+	case FilterConditionNotEqual:
+		return !date.Equal(expected)
+	case FilterConditionGreater:
+		return date.After(expected)
+	case FilterConditionGreaterOrEqual:
+		return !date.Before(expected)
+	case FilterConditionLess:
+		return date.Before(expected)
+	case FilterConditionLessOrEqual:
+		return !date.After(expected)
+	}
+	return false
+}
+
+// compareCheckbox evaluates a condition on a checkbox property, unset checkboxes being false
+func compareCheckbox(cond FilterConditionType, actual bool, value interface{}) bool {
+	switch cond {
+	case FilterConditionEmpty:
+		return !actual
+	case FilterConditionNotEmpty:
+		return actual
+	}
+
+	expected, ok := value.(bool)
+	if !ok {
+		return false
+	}
+	switch cond {
+	case FilterConditionEqual:
+		return actual == expected
+	case FilterConditionNotEqual:
+		return actual != expected
+	}
+	return false
+}
+
+// compareValues evaluates a condition on the selected values of a property
+func compareValues(cond FilterConditionType, actual, expected []string) bool {
+	has := func(value string) bool {
+		for _, a := range actual {
+			if strings.EqualFold(a, value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch cond {
+	case FilterConditionEmpty:
+		return len(actual) == 0
+	case FilterConditionNotEmpty:
+		return len(actual) > 0
+	case FilterConditionIn, FilterConditionEqual, FilterConditionContains:
+		for _, value := range expected {
+			if has(value) {
+				return true
+			}
+		}
+		return false
+	case FilterConditionNotIn, FilterConditionNotEqual, FilterConditionNotContains:
+		for _, value := range expected {
+			if has(value) {
+				return false
+			}
+		}
+		return true
+	case FilterConditionAll:
+		for _, value := range expected {
+			if !has(value) {
+				return false
+			}
+		}
+		return len(expected) > 0
+	}
+	return false
+}
+
+// compareText evaluates a condition on a textual property, ignoring case
+func compareText(cond FilterConditionType, actual string, value interface{}) bool {
+	switch cond {
+	case FilterConditionEmpty:
+		return actual == ""
+	case FilterConditionNotEmpty:
+		return actual != ""
+	}
+
+	expected := strings.ToLower(fmt.Sprint(value))
+	actual = strings.ToLower(actual)
+	switch cond {
+	case FilterConditionEqual:
+		return actual == expected
+	case FilterConditionNotEqual:
+		return actual != expected
+	case FilterConditionContains:
+		return strings.Contains(actual, expected)
+	case FilterConditionNotContains:
+		return !strings.Contains(actual, expected)
+	}
+	return false
+}
+
+// filterNumber converts a filter value to a number
+func filterNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// filterDate converts a filter value to a time
+func filterDate(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		return parseFilterDate(v)
+	}
+	return time.Time{}, false
+}
+
+// parseFilterDate parses dates in RFC 3339 or YYYY-MM-DD format
+func parseFilterDate(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// filterStrings converts a filter value to a list of strings
+func filterStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	}
+	return nil
+}
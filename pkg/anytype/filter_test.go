@@ -0,0 +1,474 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupTaggedSearchServer serves count objects where every third object is tagged "important"
+func setupTaggedSearchServer(t *testing.T, count int, onRequest func(body map[string]interface{})) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode search body: %v", err)
+		}
+		if onRequest != nil {
+			onRequest(body)
+		}
+
+		offset, _ := body["offset"].(float64)
+		limit, _ := body["limit"].(float64)
+		start, end := int(offset), int(offset+limit)
+		if end > count {
+			end = count
+		}
+
+		objects := make([]map[string]interface{}, 0)
+		for i := start; i < end; i++ {
+			tag := "other"
+			if i%3 == 0 {
+				tag = "important"
+			}
+			objects = append(objects, map[string]interface{}{
+				"id":        fmt.Sprintf("obj%d", i),
+				"relations": map[string]interface{}{"items": map[string]interface{}{"tags": []map[string]string{{"name": tag}}}},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data":       objects,
+			"pagination": Pagination{Total: count, Offset: start, Limit: int(limit), HasMore: end < count},
+		})
+	}))
+}
+
+// TestClientSideTagFilterPagination tests that Offset and Limit apply to tag-filtered results
+func TestClientSideTagFilterPagination(t *testing.T) {
+	var requests int32
+	server := setupTaggedSearchServer(t, 250, func(body map[string]interface{}) {
+		atomic.AddInt32(&requests, 1)
+		if body["limit"] != float64(filterScanPageSize) {
+			t.Errorf("Expected scan page size %d, got %v", filterScanPageSize, body["limit"])
+		}
+	})
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	results, err := client.Search(context.Background(), "space123", &SearchParams{Tags: []string{"Important"}, Offset: 40, Limit: 30})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	// 84 of the 250 objects are tagged; matches 40 to 69 are obj120 to obj207
+	if len(results.Data) != 30 || results.Data[0].ID != "obj120" || results.Data[29].ID != "obj207" {
+		t.Fatalf("Unexpected window: %d objects starting at %v", len(results.Data), results.Data)
+	}
+	// The three scanned pages hold all the objects, so Total counts all 84 matches
+	expected := Pagination{Total: 84, Offset: 40, Limit: 30, HasMore: true}
+	if results.Pagination != expected {
+		t.Errorf("Expected pagination %+v, got %+v", expected, results.Pagination)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 scan requests, got %d", requests)
+	}
+
+	// A small window is filled from the first page without scanning the rest
+	atomic.StoreInt32(&requests, 0)
+	results, err = client.Search(context.Background(), "space123", &SearchParams{Tags: []string{"important"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results.Data) != 10 || !results.Pagination.HasMore || requests != 1 {
+		t.Errorf("Expected 10 objects from a single scan request, got %d objects, %+v and %d requests",
+			len(results.Data), results.Pagination, requests)
+	}
+
+	// The pager walks the filtered results
+	objects, err := client.SearchPager("space123", &SearchParams{Tags: []string{"important"}, Limit: 50}).All(context.Background())
+	if err != nil {
+		t.Fatalf("SearchPager failed: %v", err)
+	}
+	if len(objects) != 84 {
+		t.Errorf("Expected 84 tagged objects, got %d", len(objects))
+	}
+}
+
+// TestServerSideTagFilter tests that tag filters are sent to the API when enabled
+func TestServerSideTagFilter(t *testing.T) {
+	var filters interface{}
+	var requests int32
+	server := setupTaggedSearchServer(t, 250, func(body map[string]interface{}) {
+		atomic.AddInt32(&requests, 1)
+		filters = body["filters"]
+	})
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"), WithServerSideFilters(true))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	results, err := client.Search(context.Background(), "space123", &SearchParams{Tags: []string{"important"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if requests != 1 || len(results.Data) != 10 {
+		t.Fatalf("Expected a single request returning the server page, got %d requests and %d objects", requests, len(results.Data))
+	}
+
+	expected := map[string]interface{}{
+		"operator": "and",
+		"conditions": []interface{}{
+			map[string]interface{}{"property_key": "tag", "condition": "in", "multi_select": []interface{}{"important"}},
+		},
+	}
+	if !reflect.DeepEqual(filters, expected) {
+		t.Errorf("Expected filters %v, got %v", expected, filters)
+	}
+}
+
+// TestFilterExpressionJSON tests that filter expressions survive a JSON round trip
+func TestFilterExpressionJSON(t *testing.T) {
+	original := FilterExpression{
+		Operator: FilterOperatorOr,
+		Conditions: []FilterCondition{
+			{PropertyKey: "done", Format: "checkbox", Condition: FilterConditionEqual, Value: false},
+			{PropertyKey: "description", Condition: FilterConditionEmpty},
+		},
+		Filters: []FilterExpression{
+			{Conditions: []FilterCondition{{PropertyKey: "priority", Format: "number", Condition: FilterConditionGreater, Value: 2.0}}},
+		},
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var decoded FilterExpression
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(original, decoded) {
+		t.Fatalf("Round trip mismatch:\n%+v\n%+v", original, decoded)
+	}
+	if decoded.IsEmpty() || !(&FilterExpression{Filters: []FilterExpression{{}}}).IsEmpty() {
+		t.Error("Unexpected IsEmpty result")
+	}
+}
+
+// TestTagMatchingModes tests any, all, none and ID tag matching
+func TestTagMatchingModes(t *testing.T) {
+	client, err := NewClient(WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	objects := []Object{
+		{ID: "both", Tags: []string{"Work", "Urgent"}, Properties: []Property{
+			{Name: "Tag", Format: "multi_select", MultiSelect: []PropertyTag{{ID: "tag-work", Name: "Work"}, {ID: "tag-urgent", Name: "Urgent"}}},
+		}},
+		{ID: "work", Tags: []string{"work"}, Relations: &Relations{Items: map[string][]Relation{"tags": {{ID: "tag-work", Name: "work"}}}}},
+		{ID: "none", Tags: []string{}},
+	}
+
+	tests := []struct {
+		name     string
+		params   *SearchParams
+		expected []string
+	}{
+		{"any", &SearchParams{Tags: []string{"urgent", "work"}}, []string{"both", "work"}},
+		{"all", &SearchParams{AllTags: []string{"work", "urgent"}}, []string{"both"}},
+		{"none", &SearchParams{ExcludeTags: []string{"urgent"}}, []string{"work", "none"}},
+		{"ids", &SearchParams{TagIDs: []string{"tag-work"}}, []string{"both", "work"}},
+		{"combined", &SearchParams{Tags: []string{"work"}, ExcludeTags: []string{"urgent"}}, []string{"work"}},
+	}
+
+	for _, tt := range tests {
+		match := client.tagMatcher(tt.params)
+		var got []string
+		for _, obj := range objects {
+			if match(obj) {
+				got = append(got, obj.ID)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+// TestQueryBuilderTagModes tests the query builder tag options and their server-side filters
+func TestQueryBuilderTagModes(t *testing.T) {
+	client, err := NewClient(WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	params, err := client.NewQueryBuilder("space123").
+		WithTag("work").
+		WithAllTags("a", " b ", "").
+		WithoutTags("archived").
+		WithTagIDs("tag-1").
+		GetParams()
+	if err != nil {
+		t.Fatalf("GetParams failed: %v", err)
+	}
+	if !reflect.DeepEqual(params.AllTags, []string{"a", "b"}) || params.ExcludeTags[0] != "archived" || params.TagIDs[0] != "tag-1" {
+		t.Fatalf("Unexpected params: %+v", params)
+	}
+
+	var conditions []FilterConditionType
+	for _, condition := range tagFilter(params).Conditions {
+		conditions = append(conditions, condition.Condition)
+	}
+	expected := []FilterConditionType{FilterConditionIn, FilterConditionAll, FilterConditionNotIn, FilterConditionIn}
+	if !reflect.DeepEqual(conditions, expected) {
+		t.Errorf("Expected conditions %v, got %v", expected, conditions)
+	}
+}
+
+// TestFilterMatches tests client-side evaluation of property filters
+func TestFilterMatches(t *testing.T) {
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	task := Object{
+		ID: "task",
+		Properties: []Property{
+			{Key: "due_date", Format: "date", Date: "2025-04-30T00:00:00Z"},
+			{Key: "priority", Format: "number", Number: 3},
+			{Key: "status", Format: "select", Select: &PropertyTag{ID: "opt-1", Name: "In Progress"}},
+			{Key: "source", Format: "url", URL: "https://example.com/issue/1"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filter   FilterExpression
+		expected bool
+	}{
+		{"date before", DateProperty("due_date").Before(now), true},
+		{"date after", DateProperty("due_date").After(now), false},
+		{"date not set", DateProperty("created").IsNotSet(), true},
+		{"number greater", NumberProperty("priority").GreaterThan(2), true},
+		{"number missing", NumberProperty("estimate").LessThan(10), false},
+		{"unset checkbox is false", CheckboxProperty("done").IsFalse(), true},
+		{"select by name", SelectProperty("status").In("in progress", "done"), true},
+		{"select by ID", SelectProperty("status").NotIn("opt-1"), false},
+		{"select set", SelectProperty("status").IsSet(), true},
+		{"select not set", MultiSelectProperty("labels").IsNotSet(), true},
+		{"url contains", URLProperty("source").Contains("EXAMPLE.com"), true},
+		{"and", And(NumberProperty("priority").GreaterThan(2), CheckboxProperty("done").IsTrue()), false},
+		{"or", Or(NumberProperty("priority").GreaterThan(5), CheckboxProperty("done").IsFalse()), true},
+		{"not", Not(SelectProperty("status").In("Done")), true},
+		{"empty", FilterExpression{}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.Matches(task); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+// TestQueryClauses tests that the query builder clauses add the matching filters
+func TestQueryClauses(t *testing.T) {
+	client, err := NewClient(WithURL("http://localhost"), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	qb := client.NewQueryBuilder("space123").
+		WhereSelect("status").IsNotSet().
+		WhereMultiSelect("labels").IsSet().
+		WhereText("summary").IsNotSet()
+	expected := []FilterExpression{
+		SelectProperty("status").IsNotSet(),
+		MultiSelectProperty("labels").IsSet(),
+		TextProperty("summary").IsNotSet(),
+	}
+	if qb.err != nil || !reflect.DeepEqual(qb.params.Filters.Filters, expected) {
+		t.Errorf("Unexpected filters %+v (error: %v)", qb.params.Filters, qb.err)
+	}
+
+	task := Object{Properties: []Property{{Key: "labels", Format: "multi_select", MultiSelect: []PropertyTag{{Name: "Bug"}}}}}
+	if !qb.params.Filters.Matches(task) {
+		t.Error("Expected a task without status and summary to match")
+	}
+}
+
+// TestFilterWithoutNegation tests that negations are pushed down for the server
+func TestFilterWithoutNegation(t *testing.T) {
+	filter := Not(And(NumberProperty("priority").GreaterThan(2), CheckboxProperty("done").IsTrue()))
+
+	rewritten, ok := filter.withoutNegation(false)
+	if !ok {
+		t.Fatal("Expected the filter to be rewritable")
+	}
+	if rewritten.Operator != FilterOperatorOr || rewritten.Not {
+		t.Fatalf("Unexpected rewritten filter: %+v", rewritten)
+	}
+	if rewritten.Filters[0].Conditions[0].Condition != FilterConditionLessOrEqual ||
+		rewritten.Filters[1].Conditions[0].Condition != FilterConditionNotEqual {
+		t.Errorf("Conditions were not negated: %+v", rewritten)
+	}
+
+	if _, ok := Not(MultiSelectProperty("tag").All("a", "b")).withoutNegation(false); ok {
+		t.Error("Expected a negated 'all' condition not to be rewritable")
+	}
+}
+
+// TestNegatedComparisonMissingProperty tests that negated comparisons match objects missing the property
+// both when evaluated client-side and when rewritten for the server
+func TestNegatedComparisonMissingProperty(t *testing.T) {
+	objects := []Object{
+		{ID: "small", Properties: []Property{{Key: "estimate", Format: "number", Number: 3}, {Key: "due", Format: "date", Date: "2025-07-01"}}},
+		{ID: "large", Properties: []Property{{Key: "estimate", Format: "number", Number: 8}, {Key: "due", Format: "date", Date: "2025-05-01"}}},
+		{ID: "missing"},
+	}
+	june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		filter   FilterExpression
+		expected []string
+	}{
+		{"number", Not(NumberProperty("estimate").GreaterThan(5)), []string{"small", "missing"}},
+		{"date", Not(DateProperty("due").Before(june)), []string{"small", "missing"}},
+		{"and", Not(And(NumberProperty("estimate").GreaterThan(5), DateProperty("due").Before(june))), []string{"small", "missing"}},
+		{"or", Not(Or(NumberProperty("estimate").LessThan(5), DateProperty("due").Before(june))), []string{"missing"}},
+	}
+
+	for _, tt := range tests {
+		rewritten, ok := tt.filter.withoutNegation(false)
+		if !ok {
+			t.Fatalf("%s: expected the filter to be rewritable", tt.name)
+		}
+		var client, server []string
+		for _, obj := range objects {
+			if tt.filter.Matches(obj) {
+				client = append(client, obj.ID)
+			}
+			if rewritten.Matches(obj) {
+				server = append(server, obj.ID)
+			}
+		}
+		if !reflect.DeepEqual(client, tt.expected) || !reflect.DeepEqual(server, tt.expected) {
+			t.Errorf("%s: expected %v, got %v client-side and %v for the server", tt.name, tt.expected, client, server)
+		}
+	}
+
+	// The server is asked for objects where the property is empty too
+	var filters interface{}
+	server := setupTaggedSearchServer(t, 10, func(body map[string]interface{}) {
+		filters = body["filters"]
+	})
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"), WithServerSideFilters(true))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	_, err = client.NewQueryBuilder("space123").
+		Where(Not(NumberProperty("estimate").GreaterThan(5))).
+		Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	expected := map[string]interface{}{
+		"operator": "and",
+		"filters": []interface{}{map[string]interface{}{
+			"operator": "or",
+			"conditions": []interface{}{
+				map[string]interface{}{"property_key": "estimate", "condition": "lte", "number": float64(5)},
+				map[string]interface{}{"property_key": "estimate", "condition": "empty"},
+			},
+		}},
+	}
+	if !reflect.DeepEqual(filters, expected) {
+		t.Errorf("Expected server filters %v, got %v", expected, filters)
+	}
+}
+
+// TestPropertyFilterSearch tests client-side and server-side property filtering in searches
+func TestPropertyFilterSearch(t *testing.T) {
+	var filters []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body SearchRequestBody
+		var raw map[string]interface{}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		json.Unmarshal(data, &raw)
+		filters = append(filters, raw["filters"])
+
+		objects := make([]Object, 0)
+		for i := body.Offset; i < body.Offset+body.Limit && i < 150; i++ {
+			objects = append(objects, Object{
+				ID:         fmt.Sprintf("obj%d", i),
+				Properties: []Property{{Key: "priority", Format: "number", Number: float64(i % 5)}},
+			})
+		}
+		json.NewEncoder(w).Encode(SearchResponse{
+			Data:       objects,
+			Pagination: Pagination{Total: 150, Offset: body.Offset, Limit: body.Limit, HasMore: body.Offset+body.Limit < 150},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	results, err := client.NewQueryBuilder("space123").
+		WhereNumber("priority").GreaterThan(2).
+		WithOffset(5).
+		WithLimit(10).
+		Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	// Priorities 3 and 4 match: obj3, obj4, obj8, obj9, ... so the sixth match is obj14
+	if len(results.Data) != 10 || results.Data[0].ID != "obj14" {
+		t.Fatalf("Unexpected results: %d objects starting with %+v", len(results.Data), results.Data)
+	}
+	// The first scanned page of 100 objects holds 40 matches
+	if results.Pagination.Total != 40 || !results.Pagination.HasMore {
+		t.Errorf("Unexpected pagination: %+v", results.Pagination)
+	}
+	if filters[0] != nil {
+		t.Errorf("Expected no server-side filters, got %v", filters[0])
+	}
+
+	filters = nil
+	serverClient, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"), WithServerSideFilters(true))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	_, err = serverClient.NewQueryBuilder("space123").
+		Where(Not(CheckboxProperty("done").IsTrue())).
+		Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	expected := map[string]interface{}{
+		"operator": "and",
+		"filters": []interface{}{map[string]interface{}{
+			"operator":   "or",
+			"conditions": []interface{}{map[string]interface{}{"property_key": "done", "condition": "ne", "checkbox": true}},
+		}},
+	}
+	if len(filters) != 1 || !reflect.DeepEqual(filters[0], expected) {
+		t.Errorf("Expected server filters %v, got %v", expected, filters)
+	}
+}
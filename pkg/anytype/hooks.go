@@ -0,0 +1,222 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Operation describes an API operation reported to hooks.
+type Operation struct {
+	Name         string // Name of the client method, e.g. "GetObject"
+	Method       string // HTTP method
+	PathTemplate string // Path with placeholders, e.g. "/v1/spaces/{space_id}/objects/{object_id}"
+	Path         string // Actual request path
+}
+
+// OperationResult describes the outcome of an API operation reported to hooks.
+type OperationResult struct {
+	StatusCode    int           // HTTP status code of the last attempt, 0 if no response was received
+	BytesSent     int           // Size of the request body
+	BytesReceived int           // Size of the response body
+	Duration      time.Duration // Total duration including retries and waits
+	Attempts      int           // Number of attempts made
+	Err           error         // Error returned by the operation, nil on success
+	ErrorClass    ErrorClass    // Classification of Err
+}
+
+// Hook receives notifications about every API operation made by a client.
+//
+// Implement Hook to adapt the client to tracing or metrics systems such as
+// OpenTelemetry or Prometheus. OperationStart may return a derived context,
+// for example carrying a tracing span; that context is used for the request
+// and passed to OperationEnd. Hooks must be safe for concurrent use.
+type Hook interface {
+	OperationStart(ctx context.Context, op Operation) context.Context
+	OperationEnd(ctx context.Context, op Operation, result OperationResult)
+}
+
+// WithHook registers hooks notified at the start and end of every API operation.
+//
+// Hooks are called in the order they are added.
+//
+// Example:
+//
+//	recorder := anytype.NewRecordingHook()
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithHook(recorder),
+//	)
+func WithHook(hooks ...Hook) ClientOption {
+	return func(c *Client) {
+		for _, hook := range hooks {
+			if hook != nil {
+				c.hooks = append(c.hooks, hook)
+			}
+		}
+	}
+}
+
+// ErrorClass is a coarse classification of errors suitable for metric labels.
+type ErrorClass string
+
+// Error classes reported to hooks
+const (
+	ErrorClassNone        ErrorClass = "none"
+	ErrorClassCanceled    ErrorClass = "canceled"
+	ErrorClassTimeout     ErrorClass = "timeout"
+	ErrorClassNetwork     ErrorClass = "network"
+	ErrorClassRateLimited ErrorClass = "rate_limited"
+	ErrorClassAuth        ErrorClass = "auth"
+	ErrorClassNotFound    ErrorClass = "not_found"
+	ErrorClassClient      ErrorClass = "client"
+	ErrorClassServer      ErrorClass = "server"
+	ErrorClassUnknown     ErrorClass = "unknown"
+)
+
+// ClassifyError returns the ErrorClass of an error returned by the client
+func ClassifyError(err error) ErrorClass {
+	switch {
+	case err == nil:
+		return ErrorClassNone
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, ErrOperationTimeout), errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(err, ErrRateLimited):
+		return ErrorClassRateLimited
+	case errors.Is(err, ErrNetworkError):
+		return ErrorClassNetwork
+	case IsAuthenticationError(err):
+		return ErrorClassAuth
+	case IsNotFoundError(err):
+		return ErrorClassNotFound
+	case errors.Is(err, ErrServerError):
+		return ErrorClassServer
+	case IsClientError(err):
+		return ErrorClassClient
+	}
+	return ErrorClassUnknown
+}
+
+// NoopHook is a Hook that does nothing. It can be embedded to implement only part of Hook.
+type NoopHook struct{}
+
+// OperationStart implements Hook
+func (NoopHook) OperationStart(ctx context.Context, op Operation) context.Context { return ctx }
+
+// OperationEnd implements Hook
+func (NoopHook) OperationEnd(ctx context.Context, op Operation, result OperationResult) {}
+
+// OperationEvent is an operation recorded by a RecordingHook
+type OperationEvent struct {
+	Operation Operation
+	Result    OperationResult
+}
+
+// RecordingHook is a Hook that records completed operations in memory.
+//
+// It is mainly useful in tests to assert which API calls were made.
+//
+// Example:
+//
+//	recorder := anytype.NewRecordingHook()
+//	client, _ := anytype.NewClient(anytype.WithAppKey(appKey), anytype.WithHook(recorder))
+//
+//	client.GetSpaces(ctx)
+//	for _, event := range recorder.Events() {
+//	    fmt.Printf("%s took %v\n", event.Operation.Name, event.Result.Duration)
+//	}
+type RecordingHook struct {
+	NoopHook
+
+	mu     sync.Mutex
+	events []OperationEvent
+}
+
+// NewRecordingHook creates an empty RecordingHook
+func NewRecordingHook() *RecordingHook {
+	return &RecordingHook{}
+}
+
+// OperationEnd implements Hook
+func (h *RecordingHook) OperationEnd(ctx context.Context, op Operation, result OperationResult) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, OperationEvent{Operation: op, Result: result})
+}
+
+// Events returns a copy of the recorded operations in completion order
+func (h *RecordingHook) Events() []OperationEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	events := make([]OperationEvent, len(h.events))
+	copy(events, h.events)
+	return events
+}
+
+// Reset discards all recorded operations
+func (h *RecordingHook) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = nil
+}
+
+// operationKey is the context key for the current operation
+type operationKey struct{}
+
+// withOperation annotates the context with the operation about to be performed
+func withOperation(ctx context.Context, name, pathTemplate string) context.Context {
+	return context.WithValue(ctx, operationKey{}, Operation{Name: name, PathTemplate: pathTemplate})
+}
+
+// OperationFromContext returns the operation a request belongs to.
+//
+// It can be used in middlewares to label requests, as the request context
+// carries the operation being performed.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(Operation)
+	return op, ok
+}
+
+// startOperation notifies hooks that an operation starts and returns the function ending it
+func (c *Client) startOperation(ctx context.Context, method, path string, bytesSent int) (context.Context, func(statusCode, bytesReceived, attempts int, err error)) {
+	op, _ := OperationFromContext(ctx)
+	op.Method = method
+	op.Path = path
+	if op.Name == "" {
+		op.Name = method + " " + path
+	}
+	if op.PathTemplate == "" {
+		op.PathTemplate = path
+	}
+
+	// Make the complete operation available to middlewares
+	ctx = context.WithValue(ctx, operationKey{}, op)
+
+	if len(c.hooks) == 0 {
+		return ctx, func(int, int, int, error) {}
+	}
+
+	for _, hook := range c.hooks {
+		ctx = hook.OperationStart(ctx, op)
+	}
+
+	start := time.Now()
+	return ctx, func(statusCode, bytesReceived, attempts int, err error) {
+		result := OperationResult{
+			StatusCode:    statusCode,
+			BytesSent:     bytesSent,
+			BytesReceived: bytesReceived,
+			Duration:      time.Since(start),
+			Attempts:      attempts,
+			Err:           err,
+			ErrorClass:    ClassifyError(err),
+		}
+		for _, hook := range c.hooks {
+			hook.OperationEnd(ctx, op, result)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRecordingHook tests that operations are reported to hooks with their outcome
+func TestRecordingHook(t *testing.T) {
+	response := `{"object": {"id": "obj123", "name": "Test Object"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/spaces/space123/objects/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	recorder := NewRecordingHook()
+	client, err := NewClient(
+		WithURL(server.URL),
+		WithAppKey("test-app-key"),
+		WithHook(recorder),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.GetObject(ctx, &GetObjectParams{SpaceID: "space123", ObjectID: "obj123"}); err != nil {
+		t.Fatalf("GetObject failed: %v", err)
+	}
+	if _, err := client.GetObject(ctx, &GetObjectParams{SpaceID: "space123", ObjectID: "missing"}); err == nil {
+		t.Fatal("Expected an error for a missing object")
+	}
+
+	events := recorder.Events()
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+
+	success := events[0]
+	if success.Operation.Name != "GetObject" {
+		t.Errorf("Expected operation name 'GetObject', got %q", success.Operation.Name)
+	}
+	if success.Operation.PathTemplate != "/v1/spaces/{space_id}/objects/{object_id}" {
+		t.Errorf("Unexpected path template: %q", success.Operation.PathTemplate)
+	}
+	if success.Operation.Path != "/v1/spaces/space123/objects/obj123" {
+		t.Errorf("Unexpected path: %q", success.Operation.Path)
+	}
+	if success.Result.StatusCode != http.StatusOK || success.Result.ErrorClass != ErrorClassNone {
+		t.Errorf("Unexpected success result: %+v", success.Result)
+	}
+	if success.Result.BytesReceived != len(response) || success.Result.Attempts != 1 {
+		t.Errorf("Unexpected success result: %+v", success.Result)
+	}
+
+	failure := events[1]
+	if failure.Result.StatusCode != http.StatusNotFound || failure.Result.ErrorClass != ErrorClassNotFound {
+		t.Errorf("Unexpected failure result: %+v", failure.Result)
+	}
+	if failure.Result.Err == nil {
+		t.Error("Expected the failure result to carry the error")
+	}
+
+	recorder.Reset()
+	if len(recorder.Events()) != 0 {
+		t.Fatal("Expected no events after reset")
+	}
+}
+
+// contextHook stores a value in the context to check it reaches the request and the end of the operation
+type contextHook struct {
+	NoopHook
+	ended bool
+}
+
+type contextHookKey struct{}
+
+func (h *contextHook) OperationStart(ctx context.Context, op Operation) context.Context {
+	return context.WithValue(ctx, contextHookKey{}, op.Name)
+}
+
+func (h *contextHook) OperationEnd(ctx context.Context, op Operation, result OperationResult) {
+	h.ended = ctx.Value(contextHookKey{}) == op.Name
+}
+
+// TestHookContextPropagation tests that the context returned by OperationStart is used for the request
+func TestHookContextPropagation(t *testing.T) {
+	hook := &contextHook{}
+	var seen interface{}
+	var seenOp Operation
+	inspect := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			seen = req.Context().Value(contextHookKey{})
+			seenOp, _ = OperationFromContext(req.Context())
+			rec := httptest.NewRecorder()
+			rec.WriteString(`{"data": [], "pagination": {}}`)
+			return rec.Result(), nil
+		}
+	}
+
+	client, err := NewClient(WithAppKey("test-app-key"), WithHook(hook), WithMiddleware(inspect))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.GetMembers(context.Background(), "space123"); err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if seen != "GetMembers" {
+		t.Errorf("Expected the hook context to reach the request, got %v", seen)
+	}
+	if seenOp.PathTemplate != "/v1/spaces/{space_id}/members" || seenOp.Method != http.MethodGet {
+		t.Errorf("Unexpected operation in request context: %+v", seenOp)
+	}
+	if !hook.ended {
+		t.Error("Expected OperationEnd to receive the hook context")
+	}
+}
+
+// TestClassifyError tests error classification
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected ErrorClass
+	}{
+		{nil, ErrorClassNone},
+		{context.Canceled, ErrorClassCanceled},
+		{WrapError("/test", 0, "timed out", ErrOperationTimeout), ErrorClassTimeout},
+		{WrapError("/test", 429, "slow down", ErrRateLimited), ErrorClassRateLimited},
+		{fmt.Errorf("%w: connection refused", ErrNetworkError), ErrorClassNetwork},
+		{WrapError("/test", 401, "unauthorized", ErrUnauthorized), ErrorClassAuth},
+		{WrapError("/test", 404, "not found", ErrNotFound), ErrorClassNotFound},
+		{WrapError("/test", 500, "boom", ErrServerError), ErrorClassServer},
+		{WrapError("/test", 400, "bad request", StatusCodeToError(400)), ErrorClassClient},
+		{errors.New("something else"), ErrorClassUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := ClassifyError(tt.err); got != tt.expected {
+			t.Errorf("ClassifyError(%v) = %q, expected %q", tt.err, got, tt.expected)
+		}
+	}
+}
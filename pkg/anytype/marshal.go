@@ -0,0 +1,543 @@
+package anytype
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Struct tag mapping between Go values and objects.
+//
+// Fields are mapped with the anytype struct tag, whose value is the key of a
+// property optionally followed by its format and options:
+//
+//	type Incident struct {
+//	    ID       string    `anytype:"id"`
+//	    Title    string    `anytype:"name"`
+//	    Icon     string    `anytype:"icon"`
+//	    Labels   []string  `anytype:"tags"`
+//	    Severity string    `anytype:"severity,select"`
+//	    Services []string  `anytype:"services,multi_select"`
+//	    DueDate  time.Time `anytype:"due_date,date"`
+//	    Impact   *float64  `anytype:"impact,omitempty"`
+//	    Resolved bool      `anytype:"resolved"`
+//	}
+//
+// The keys id, name, type, icon and tags map to the ID, name, type key, emoji
+// icon and tags of the object instead of a property. When the format is
+// omitted it is inferred from the Go type: strings are text, numbers are
+// numbers, booleans are checkboxes, time.Time values are dates, PropertyTag
+// values are selects and []PropertyTag values are multi_selects. Selects and
+// multi_selects can also be strings holding tag names, and objects and files
+// are []string holding IDs.
+//
+// Fields without a tag, or tagged "-", are ignored. Nil pointers are left out
+// of the object, and the omitempty option leaves out zero values; other zero
+// values are written, so that a false checkbox or a 0 number is sent.
+
+// Keys of struct tags mapping to object fields instead of properties
+const (
+	fieldKeyID   = "id"
+	fieldKeyName = "name"
+	fieldKeyType = "type"
+	fieldKeyIcon = "icon"
+	fieldKeyTags = "tags"
+)
+
+// TypeKeyer is implemented by values that know the key of their type.
+// Marshal uses it to set the type of the object when no field is tagged "type".
+type TypeKeyer interface {
+	AnytypeTypeKey() string
+}
+
+var (
+	timeType         = reflect.TypeOf(time.Time{})
+	iconType         = reflect.TypeOf(Icon{})
+	propertyTagType  = reflect.TypeOf(PropertyTag{})
+	stringSliceType  = reflect.TypeOf([]string{})
+	propertyTagsType = reflect.TypeOf([]PropertyTag{})
+)
+
+// fieldMapping maps a struct field to an object field or property
+type fieldMapping struct {
+	name      string         // Name of the struct field, for errors
+	index     []int          // Index of the struct field
+	key       string         // Property key, or one of the fieldKey constants
+	format    PropertyFormat // Property format, empty for object fields
+	omitEmpty bool           // Whether zero values are left out
+}
+
+// Marshal converts a struct, or a pointer to a struct, to an object using its
+// anytype struct tags.
+//
+// Example:
+//
+//	incident := Incident{
+//	    Title:    "Database outage",
+//	    Severity: "SEV1",
+//	    DueDate:  time.Now().Add(24 * time.Hour),
+//	}
+//
+//	object, err := anytype.Marshal(incident)
+//	if err != nil {
+//	    log.Fatalf("Failed to convert incident: %v", err)
+//	}
+func Marshal(v interface{}) (*Object, error) {
+	value, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := fieldMappings(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &Object{}
+	if keyer, ok := v.(TypeKeyer); ok && keyer.AnytypeTypeKey() != "" {
+		obj.Type = &TypeInfo{Key: keyer.AnytypeTypeKey()}
+	}
+
+	for _, mapping := range mappings {
+		field, err := value.FieldByIndexErr(mapping.index)
+		if err != nil {
+			// Fields promoted through a nil embedded pointer are left out
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+		if mapping.omitEmpty && field.IsZero() {
+			continue
+		}
+		marshalField(obj, mapping, field)
+	}
+	return obj, nil
+}
+
+// Unmarshal copies the name, icon, tags and properties of an object to the
+// fields of the struct pointed to by v, using its anytype struct tags.
+//
+// Fields whose property is missing from the object, or has another format,
+// are left unchanged, so pointer fields stay nil when the object doesn't have
+// the property.
+//
+// Example:
+//
+//	var incident Incident
+//	if err := anytype.Unmarshal(object, &incident); err != nil {
+//	    log.Fatalf("Failed to read incident: %v", err)
+//	}
+func Unmarshal(obj *Object, v interface{}) error {
+	if obj == nil {
+		return fmt.Errorf("%w: object is required", ErrInvalidParameter)
+	}
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: Unmarshal needs a non-nil pointer to a struct, got %T", ErrInvalidParameter, v)
+	}
+	value := target.Elem()
+	mappings, err := fieldMappings(value.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, mapping := range mappings {
+		field, err := value.FieldByIndexErr(mapping.index)
+		if err != nil {
+			continue
+		}
+		if err := unmarshalField(obj, mapping, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structValue returns the struct held by v, dereferencing pointers
+func structValue(v interface{}) (reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%w: Marshal needs a struct, got %T", ErrInvalidParameter, v)
+	}
+	return value, nil
+}
+
+// fieldMappings parses the anytype struct tags of a struct type
+func fieldMappings(structType reflect.Type) ([]fieldMapping, error) {
+	var mappings []fieldMapping
+	for _, field := range reflect.VisibleFields(structType) {
+		tag, ok := field.Tag.Lookup("anytype")
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		mapping := fieldMapping{name: field.Name, index: field.Index, key: strings.TrimSpace(parts[0])}
+		if mapping.key == "" {
+			return nil, fmt.Errorf("%w: field %s has an empty anytype key", ErrInvalidParameter, field.Name)
+		}
+		for _, option := range parts[1:] {
+			switch option = strings.TrimSpace(option); {
+			case option == "omitempty":
+				mapping.omitEmpty = true
+			case PropertyFormat(option).IsValid() && mapping.format == "":
+				mapping.format = PropertyFormat(option)
+			default:
+				return nil, fmt.Errorf("%w: field %s has an invalid anytype option %q", ErrInvalidParameter, field.Name, option)
+			}
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer && fieldType.Elem() != iconType {
+			fieldType = fieldType.Elem()
+		}
+		if err := checkFieldType(&mapping, fieldType); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
+}
+
+// checkFieldType checks that a field can hold the values of its mapping,
+// inferring the format of properties that don't declare one
+func checkFieldType(mapping *fieldMapping, fieldType reflect.Type) error {
+	switch mapping.key {
+	case fieldKeyID, fieldKeyName, fieldKeyType:
+		if mapping.format != "" || fieldType.Kind() != reflect.String {
+			return fmt.Errorf("%w: field %s tagged %s must be a string", ErrInvalidParameter, mapping.name, mapping.key)
+		}
+		return nil
+	case fieldKeyIcon:
+		if mapping.format != "" || (fieldType.Kind() != reflect.String && fieldType != iconType && fieldType != reflect.PointerTo(iconType)) {
+			return fmt.Errorf("%w: field %s tagged icon must be a string, an Icon or an *Icon", ErrInvalidParameter, mapping.name)
+		}
+		return nil
+	case fieldKeyTags:
+		if mapping.format != "" || fieldType != stringSliceType {
+			return fmt.Errorf("%w: field %s tagged tags must be a []string", ErrInvalidParameter, mapping.name)
+		}
+		return nil
+	}
+
+	if mapping.format == "" {
+		switch {
+		case fieldType == timeType:
+			mapping.format = PropertyFormatDate
+		case fieldType == propertyTagType:
+			mapping.format = PropertyFormatSelect
+		case fieldType == propertyTagsType:
+			mapping.format = PropertyFormatMultiSelect
+		case fieldType.Kind() == reflect.String:
+			mapping.format = PropertyFormatText
+		case fieldType.Kind() == reflect.Bool:
+			mapping.format = PropertyFormatCheckbox
+		case isNumberKind(fieldType.Kind()):
+			mapping.format = PropertyFormatNumber
+		default:
+			return fmt.Errorf("%w: field %s of type %s needs a property format", ErrInvalidParameter, mapping.name, fieldType)
+		}
+		return nil
+	}
+
+	var ok bool
+	switch mapping.format {
+	case PropertyFormatText, PropertyFormatURL, PropertyFormatEmail, PropertyFormatPhone:
+		ok = fieldType.Kind() == reflect.String
+	case PropertyFormatNumber:
+		ok = isNumberKind(fieldType.Kind())
+	case PropertyFormatCheckbox:
+		ok = fieldType.Kind() == reflect.Bool
+	case PropertyFormatDate:
+		ok = fieldType == timeType || fieldType.Kind() == reflect.String
+	case PropertyFormatSelect:
+		ok = fieldType == propertyTagType || fieldType.Kind() == reflect.String
+	case PropertyFormatMultiSelect:
+		ok = fieldType == propertyTagsType || fieldType == stringSliceType
+	case PropertyFormatObjects, PropertyFormatFiles:
+		ok = fieldType == stringSliceType
+	}
+	if !ok {
+		return fmt.Errorf("%w: field %s of type %s can't hold %s property %s",
+			ErrInvalidParameter, mapping.name, fieldType, mapping.format, mapping.key)
+	}
+	return nil
+}
+
+// isNumberKind reports whether values of a kind are numbers
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// marshalField copies the value of a field to the object
+func marshalField(obj *Object, mapping fieldMapping, field reflect.Value) {
+	switch mapping.key {
+	case fieldKeyID:
+		obj.ID = field.String()
+		return
+	case fieldKeyName:
+		obj.Name = field.String()
+		return
+	case fieldKeyType:
+		if field.String() != "" {
+			obj.Type = &TypeInfo{Key: field.String()}
+		}
+		return
+	case fieldKeyIcon:
+		if field.Kind() == reflect.String {
+			if field.String() != "" {
+				obj.Icon = &Icon{Format: "emoji", Emoji: field.String()}
+			}
+			return
+		}
+		icon := field.Interface().(Icon)
+		obj.Icon = &icon
+		return
+	case fieldKeyTags:
+		obj.Tags = append([]string(nil), field.Interface().([]string)...)
+		return
+	}
+
+	switch mapping.format {
+	case PropertyFormatText:
+		obj.SetText(mapping.key, field.String())
+	case PropertyFormatURL:
+		obj.SetURL(mapping.key, field.String())
+	case PropertyFormatEmail:
+		obj.SetEmail(mapping.key, field.String())
+	case PropertyFormatPhone:
+		obj.SetPhone(mapping.key, field.String())
+	case PropertyFormatNumber:
+		obj.SetNumber(mapping.key, numberValue(field))
+	case PropertyFormatCheckbox:
+		obj.SetCheckbox(mapping.key, field.Bool())
+	case PropertyFormatDate:
+		switch {
+		case field.IsZero():
+			obj.ClearProperty(mapping.key, PropertyFormatDate)
+		case field.Type() == timeType:
+			obj.SetDate(mapping.key, field.Interface().(time.Time))
+		default:
+			obj.setProperty(Property{Key: mapping.key, Format: string(PropertyFormatDate), Date: field.String()})
+		}
+	case PropertyFormatSelect:
+		switch {
+		case field.IsZero():
+			obj.ClearProperty(mapping.key, PropertyFormatSelect)
+		case field.Type() == propertyTagType:
+			obj.SetSelect(mapping.key, field.Interface().(PropertyTag))
+		default:
+			obj.SetSelect(mapping.key, PropertyTag{Name: field.String()})
+		}
+	case PropertyFormatMultiSelect:
+		if field.Type() == propertyTagsType {
+			obj.SetMultiSelect(mapping.key, field.Interface().([]PropertyTag)...)
+			return
+		}
+		var tags []PropertyTag
+		for _, name := range field.Interface().([]string) {
+			tags = append(tags, PropertyTag{Name: name})
+		}
+		obj.SetMultiSelect(mapping.key, tags...)
+	case PropertyFormatObjects:
+		obj.SetObjects(mapping.key, field.Interface().([]string)...)
+	case PropertyFormatFiles:
+		obj.SetFiles(mapping.key, field.Interface().([]string)...)
+	}
+}
+
+// numberValue returns the value of a number field as a float64
+func numberValue(field reflect.Value) float64 {
+	switch {
+	case field.CanInt():
+		return float64(field.Int())
+	case field.CanUint():
+		return float64(field.Uint())
+	default:
+		return field.Float()
+	}
+}
+
+// unmarshalField copies an object field or property to a struct field
+func unmarshalField(obj *Object, mapping fieldMapping, field reflect.Value) error {
+	value, ok, err := objectValue(obj, mapping, field.Type())
+	if err != nil || !ok {
+		return err
+	}
+
+	if field.Kind() == reflect.Pointer && field.Type().Elem() != iconType {
+		ptr := reflect.New(field.Type().Elem())
+		setValue(ptr.Elem(), value)
+		field.Set(ptr)
+		return nil
+	}
+	setValue(field, value)
+	return nil
+}
+
+// setValue sets a field to a value read from an object, converting numbers
+// and named types to the type of the field
+func setValue(field reflect.Value, value interface{}) {
+	switch v := value.(type) {
+	case float64:
+		switch {
+		case field.CanInt():
+			field.SetInt(int64(v))
+		case field.CanUint():
+			field.SetUint(uint64(v))
+		default:
+			field.SetFloat(v)
+		}
+	default:
+		field.Set(reflect.ValueOf(value).Convert(field.Type()))
+	}
+}
+
+// objectValue returns the value of an object field or property mapped to a
+// struct field, and whether the object has it
+func objectValue(obj *Object, mapping fieldMapping, fieldType reflect.Type) (interface{}, bool, error) {
+	if fieldType.Kind() == reflect.Pointer && fieldType.Elem() != iconType {
+		fieldType = fieldType.Elem()
+	}
+
+	switch mapping.key {
+	case fieldKeyID:
+		return obj.ID, obj.ID != "", nil
+	case fieldKeyName:
+		return obj.Name, obj.Name != "", nil
+	case fieldKeyType:
+		if obj.Type == nil || obj.Type.Key == "" {
+			return nil, false, nil
+		}
+		return obj.Type.Key, true, nil
+	case fieldKeyIcon:
+		if obj.Icon == nil {
+			return nil, false, nil
+		}
+		switch {
+		case fieldType.Kind() == reflect.String:
+			return obj.Icon.Emoji, obj.Icon.Emoji != "", nil
+		case fieldType == iconType:
+			return *obj.Icon, true, nil
+		default:
+			icon := *obj.Icon
+			return &icon, true, nil
+		}
+	case fieldKeyTags:
+		return append([]string{}, obj.Tags...), obj.Tags != nil, nil
+	}
+
+	prop, ok := obj.typedProperty(mapping.key, mapping.format)
+	if !ok {
+		return nil, false, nil
+	}
+
+	switch mapping.format {
+	case PropertyFormatText:
+		return prop.Text, true, nil
+	case PropertyFormatURL:
+		return prop.URL, true, nil
+	case PropertyFormatEmail:
+		return prop.Email, true, nil
+	case PropertyFormatPhone:
+		return prop.Phone, true, nil
+	case PropertyFormatNumber:
+		return prop.Number, true, nil
+	case PropertyFormatCheckbox:
+		return prop.Checkbox, true, nil
+	case PropertyFormatDate:
+		if prop.Date == "" {
+			return nil, false, nil
+		}
+		if fieldType.Kind() == reflect.String {
+			return prop.Date, true, nil
+		}
+		date, ok := parseFilterDate(prop.Date)
+		if !ok {
+			return nil, false, fmt.Errorf("%w: property %s has an invalid date %q", ErrInvalidResponse, mapping.key, prop.Date)
+		}
+		return date, true, nil
+	case PropertyFormatSelect:
+		if prop.Select == nil {
+			return nil, false, nil
+		}
+		if fieldType.Kind() == reflect.String {
+			return prop.Select.Name, true, nil
+		}
+		return *prop.Select, true, nil
+	case PropertyFormatMultiSelect:
+		if fieldType == propertyTagsType {
+			return append([]PropertyTag{}, prop.MultiSelect...), true, nil
+		}
+		names := make([]string, 0, len(prop.MultiSelect))
+		for _, tag := range prop.MultiSelect {
+			names = append(names, tag.Name)
+		}
+		return names, true, nil
+	case PropertyFormatObjects:
+		return append([]string{}, prop.Object...), true, nil
+	case PropertyFormatFiles:
+		return append([]string{}, prop.File...), true, nil
+	}
+	return nil, false, nil
+}
+
+// CreateTyped creates an object from a struct with anytype struct tags.
+//
+// The type of the object is read from the field tagged "type", or from the
+// AnytypeTypeKey method of the value. When v is a pointer, the created object
+// is copied back to it, which sets the field tagged "id".
+//
+// Example:
+//
+//	type Incident struct {
+//	    ID       string `anytype:"id"`
+//	    Title    string `anytype:"name"`
+//	    Severity string `anytype:"severity,select"`
+//	}
+//
+//	func (Incident) AnytypeTypeKey() string { return "incident" }
+//
+//	incident := &Incident{Title: "Database outage", Severity: "SEV1"}
+//	if _, err := client.CreateTyped(ctx, "space123", incident); err != nil {
+//	    log.Fatalf("Failed to create incident: %v", err)
+//	}
+//	fmt.Printf("Created incident with ID: %s\n", incident.ID)
+func (c *Client) CreateTyped(ctx context.Context, spaceID string, v interface{}) (*Object, error) {
+	if spaceID == "" {
+		return nil, ErrInvalidSpaceID
+	}
+	object, err := Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if object.Type == nil || object.Type.Key == "" {
+		return nil, fmt.Errorf("%w: %T has no type key, tag a field with anytype:\"type\" or implement TypeKeyer", ErrInvalidTypeID, v)
+	}
+
+	created, err := c.createObject(ctx, spaceID, object)
+	if err != nil {
+		return nil, err
+	}
+
+	if target := reflect.ValueOf(v); target.Kind() == reflect.Pointer && !target.IsNil() {
+		if err := Unmarshal(created, v); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
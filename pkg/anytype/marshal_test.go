@@ -0,0 +1,196 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testIncident is a struct mapped to objects of the incident type
+type testIncident struct {
+	ID       string        `anytype:"id"`
+	Title    string        `anytype:"name"`
+	Icon     string        `anytype:"icon"`
+	Labels   []string      `anytype:"tags"`
+	Summary  string        `anytype:"summary"`
+	Severity string        `anytype:"severity,select"`
+	Services []string      `anytype:"services,multi_select"`
+	Owner    PropertyTag   `anytype:"owner"`
+	Related  []string      `anytype:"related,objects"`
+	Runbook  string        `anytype:"runbook,url"`
+	DueDate  time.Time     `anytype:"due_date,date"`
+	Impact   *float64      `anytype:"impact"`
+	Count    int           `anytype:"count,omitempty"`
+	Resolved bool          `anytype:"resolved"`
+	Notes    string        `anytype:"-"`
+	Internal string        // Not mapped
+	Attached []string      `anytype:"attachments,files,omitempty"`
+	Reporter *PropertyTag  `anytype:"reporter,select"`
+	Tags     []PropertyTag `anytype:"labels"`
+}
+
+func (testIncident) AnytypeTypeKey() string { return "incident" }
+
+// TestMarshal tests converting a struct to an object
+func TestMarshal(t *testing.T) {
+	due := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	obj, err := Marshal(testIncident{
+		Title:    "Database outage",
+		Icon:     "🚨",
+		Labels:   []string{"ops"},
+		Severity: "SEV1",
+		Services: []string{"api", "db"},
+		Related:  []string{"obj1"},
+		DueDate:  due,
+		Notes:    "ignored",
+		Internal: "ignored",
+	})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	if obj.Name != "Database outage" || obj.Type == nil || obj.Type.Key != "incident" ||
+		obj.Icon == nil || obj.Icon.Emoji != "🚨" || !reflect.DeepEqual(obj.Tags, []string{"ops"}) {
+		t.Errorf("Unexpected object fields: %+v", obj)
+	}
+	if tag, ok := obj.GetSelect("severity"); !ok || tag.Name != "SEV1" {
+		t.Errorf("Expected severity SEV1, got %+v (%v)", tag, ok)
+	}
+	if tags, ok := obj.GetMultiSelect("services"); !ok || len(tags) != 2 || tags[1].Name != "db" {
+		t.Errorf("Expected services, got %+v (%v)", tags, ok)
+	}
+	if date, ok := obj.GetDate("due_date"); !ok || !date.Equal(due) {
+		t.Errorf("Expected due date, got %v (%v)", date, ok)
+	}
+
+	// Zero values are written, nil pointers and omitempty zero values are left out
+	if resolved, ok := obj.GetCheckbox("resolved"); !ok || resolved {
+		t.Errorf("Expected an unchecked resolved checkbox, got %v (%v)", resolved, ok)
+	}
+	if summary, ok := obj.GetText("summary"); !ok || summary != "" {
+		t.Errorf("Expected an empty summary, got %q (%v)", summary, ok)
+	}
+	for _, key := range []string{"impact", "count", "attachments", "reporter", "notes", "Notes", "Internal"} {
+		if obj.HasProperty(key) {
+			t.Errorf("Expected %s to be left out", key)
+		}
+	}
+	if prop, ok := obj.GetProperty("owner"); !ok || prop.Format != "select" || prop.Select != nil {
+		t.Errorf("Expected an empty owner select, got %+v (%v)", prop, ok)
+	}
+}
+
+// TestUnmarshal tests copying an object to a struct
+func TestUnmarshal(t *testing.T) {
+	obj := &Object{
+		ID:   "obj123",
+		Name: "Database outage",
+		Type: &TypeInfo{Key: "incident"},
+		Icon: &Icon{Format: "emoji", Emoji: "🚨"},
+		Tags: []string{"ops"},
+		Properties: []Property{
+			{Key: "severity", Format: "select", Select: &PropertyTag{ID: "tag1", Name: "SEV1"}},
+			{Key: "services", Format: "multi_select", MultiSelect: []PropertyTag{{Name: "api"}}},
+			{Key: "due_date", Format: "date", Date: "2024-03-01"},
+			{Key: "impact", Format: "number", Number: 0},
+			{Key: "count", Format: "number", Number: 3},
+			{Key: "resolved", Format: "checkbox", Checkbox: true},
+			{Key: "runbook", Format: "text", Text: "not a url"},
+		},
+	}
+
+	incident := testIncident{Runbook: "unchanged"}
+	if err := Unmarshal(obj, &incident); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if incident.ID != "obj123" || incident.Title != "Database outage" || incident.Icon != "🚨" ||
+		!reflect.DeepEqual(incident.Labels, []string{"ops"}) {
+		t.Errorf("Unexpected object fields: %+v", incident)
+	}
+	if incident.Severity != "SEV1" || !reflect.DeepEqual(incident.Services, []string{"api"}) ||
+		!incident.DueDate.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) || incident.Count != 3 || !incident.Resolved {
+		t.Errorf("Unexpected property fields: %+v", incident)
+	}
+
+	// Present zero values are set, missing properties and format mismatches are left unchanged
+	if incident.Impact == nil || *incident.Impact != 0 {
+		t.Errorf("Expected a zero impact, got %v", incident.Impact)
+	}
+	if incident.Reporter != nil || incident.Runbook != "unchanged" {
+		t.Errorf("Expected missing properties to be left unchanged: %+v", incident)
+	}
+
+	// Marshaling the result gives the same property values back
+	round, err := Marshal(&incident)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if count, _ := round.GetNumber("count"); count != 3 {
+		t.Errorf("Expected count 3 after a round trip, got %v", count)
+	}
+}
+
+// TestMarshalInvalidTags tests that struct tags not matching their field are rejected
+func TestMarshalInvalidTags(t *testing.T) {
+	invalid := []interface{}{
+		struct {
+			Due time.Time `anytype:"due,checkbox"`
+		}{},
+		struct {
+			IDs []string `anytype:"ids"`
+		}{},
+		struct {
+			Name int `anytype:"name"`
+		}{},
+		struct {
+			Size int `anytype:"size,number,bogus"`
+		}{},
+		"not a struct",
+	}
+	for _, v := range invalid {
+		if _, err := Marshal(v); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("Expected ErrInvalidParameter for %T, got %v", v, err)
+		}
+	}
+
+	var incident testIncident
+	if err := Unmarshal(&Object{}, incident); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter for a non-pointer, got %v", err)
+	}
+}
+
+// TestCreateTyped tests creating an object from a struct
+func TestCreateTyped(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"object": {"id": "obj789", "name": "Database outage", "type": {"key": "incident"},
+		"properties": [{"key": "severity", "format": "select", "select": {"id": "tag1", "name": "SEV1"}}]}}`, &requests)
+	defer server.Close()
+
+	incident := &testIncident{Title: "Database outage", Severity: "SEV1"}
+	created, err := client.CreateTyped(context.Background(), "space123", incident)
+	if err != nil {
+		t.Fatalf("CreateTyped failed: %v", err)
+	}
+	if created.ID != "obj789" || incident.ID != "obj789" {
+		t.Errorf("Expected the created ID to be copied back, got %q and %q", created.ID, incident.ID)
+	}
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPost || requests[0].Path != "/v1/spaces/space123/objects" {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	body := requests[0].Body
+	objectType, _ := body["type"].(map[string]interface{})
+	if body["name"] != "Database outage" || objectType["key"] != "incident" || body["id"] != nil {
+		t.Errorf("Unexpected request body: %v", body)
+	}
+
+	untyped := struct {
+		Title string `anytype:"name"`
+	}{Title: "No type"}
+	if _, err := client.CreateTyped(context.Background(), "space123", untyped); !errors.Is(err, ErrInvalidTypeID) {
+		t.Errorf("Expected ErrInvalidTypeID without a type key, got %v", err)
+	}
+}
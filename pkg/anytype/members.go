@@ -0,0 +1,197 @@
+package anytype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MemberRole is the role of a member in a space
+type MemberRole string
+
+// Member roles
+const (
+	MemberRoleViewer       MemberRole = "viewer"
+	MemberRoleEditor       MemberRole = "editor"
+	MemberRoleOwner        MemberRole = "owner"
+	MemberRoleNoPermission MemberRole = "no_permission"
+)
+
+// IsValid reports whether the role is a known role
+func (r MemberRole) IsValid() bool {
+	switch r {
+	case MemberRoleViewer, MemberRoleEditor, MemberRoleOwner, MemberRoleNoPermission:
+		return true
+	}
+	return false
+}
+
+// MemberStatus is the status of a member in a space
+type MemberStatus string
+
+// Member statuses
+const (
+	MemberStatusJoining  MemberStatus = "joining"
+	MemberStatusActive   MemberStatus = "active"
+	MemberStatusRemoved  MemberStatus = "removed"
+	MemberStatusDeclined MemberStatus = "declined"
+	MemberStatusRemoving MemberStatus = "removing"
+	MemberStatusCanceled MemberStatus = "canceled"
+)
+
+// IsValid reports whether the status is a known status
+func (s MemberStatus) IsValid() bool {
+	switch s {
+	case MemberStatusJoining, MemberStatusActive, MemberStatusRemoved,
+		MemberStatusDeclined, MemberStatusRemoving, MemberStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// UpdateMemberParams represents parameters for updating a member of a space.
+//
+// Setting Status to active approves a join request, declined declines it and
+// removed removes the member from the space. Only the fields that are set are
+// updated.
+type UpdateMemberParams struct {
+	SpaceID  string       `json:"-"`                // Space ID the member belongs to
+	MemberID string       `json:"-"`                // Member ID to update
+	Status   MemberStatus `json:"status,omitempty"` // New status: active, declined or removed
+	Role     MemberRole   `json:"role,omitempty"`   // New role: viewer, editor or owner
+}
+
+// Validate validates UpdateMemberParams fields
+func (p *UpdateMemberParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.MemberID == "" {
+		return fmt.Errorf("member ID is required: %w", ErrMissingRequired)
+	}
+	if p.Status == "" && p.Role == "" {
+		return fmt.Errorf("nothing to update: %w", ErrMissingRequired)
+	}
+	switch p.Status {
+	case "", MemberStatusActive, MemberStatusDeclined, MemberStatusRemoved:
+	default:
+		return fmt.Errorf("invalid member status %q, expected active, declined or removed: %w", p.Status, ErrInvalidParameter)
+	}
+	switch p.Role {
+	case "", MemberRoleViewer, MemberRoleEditor, MemberRoleOwner:
+	default:
+		return fmt.Errorf("invalid member role %q, expected viewer, editor or owner: %w", p.Role, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// GetMember retrieves a member of a space by ID
+func (c *Client) GetMember(ctx context.Context, spaceID, memberID string) (*Member, error) {
+	if spaceID == "" {
+		return nil, ErrInvalidSpaceID
+	}
+	if memberID == "" {
+		return nil, wrapError("/v1/spaces/{id}/members/{member_id}", 0, "member ID is required", ErrMissingRequired)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/members/%s", spaceID, memberID)
+	ctx = withOperation(ctx, "GetMember", "/v1/spaces/{space_id}/members/{member_id}")
+	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to get member %s", memberID), err)
+	}
+
+	return parseMemberResponse(path, data)
+}
+
+// UpdateMember updates the status or the role of a member of a space.
+//
+// Example:
+//
+//	// Approve a join request with editor rights
+//	member, err := client.UpdateMember(ctx, &anytype.UpdateMemberParams{
+//	    SpaceID:  "space123",
+//	    MemberID: "member456",
+//	    Status:   anytype.MemberStatusActive,
+//	    Role:     anytype.MemberRoleEditor,
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to approve member: %v", err)
+//	}
+func (c *Client) UpdateMember(ctx context.Context, params *UpdateMemberParams) (*Member, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/members/{member_id}", 0, "invalid member parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/members/%s", params.SpaceID, params.MemberID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal member", err)
+	}
+
+	ctx = withOperation(ctx, "UpdateMember", "/v1/spaces/{space_id}/members/{member_id}")
+	data, err := c.makeRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to update member %s", params.MemberID), err)
+	}
+
+	return parseMemberResponse(path, data)
+}
+
+// ApproveMember approves the join request of a member with the given role,
+// viewer or editor
+func (c *Client) ApproveMember(ctx context.Context, spaceID, memberID string, role MemberRole) (*Member, error) {
+	return c.UpdateMember(ctx, &UpdateMemberParams{
+		SpaceID:  spaceID,
+		MemberID: memberID,
+		Status:   MemberStatusActive,
+		Role:     role,
+	})
+}
+
+// DeclineMember declines the join request of a member
+func (c *Client) DeclineMember(ctx context.Context, spaceID, memberID string) (*Member, error) {
+	return c.UpdateMember(ctx, &UpdateMemberParams{
+		SpaceID:  spaceID,
+		MemberID: memberID,
+		Status:   MemberStatusDeclined,
+	})
+}
+
+// UpdateMemberRole changes the role of an active member
+func (c *Client) UpdateMemberRole(ctx context.Context, spaceID, memberID string, role MemberRole) (*Member, error) {
+	if role == "" {
+		return nil, wrapError("/v1/spaces/{id}/members/{member_id}", 0, "member role is required", ErrMissingRequired)
+	}
+	return c.UpdateMember(ctx, &UpdateMemberParams{
+		SpaceID:  spaceID,
+		MemberID: memberID,
+		Role:     role,
+	})
+}
+
+// RemoveMember removes a member from a space
+func (c *Client) RemoveMember(ctx context.Context, spaceID, memberID string) (*Member, error) {
+	return c.UpdateMember(ctx, &UpdateMemberParams{
+		SpaceID:  spaceID,
+		MemberID: memberID,
+		Status:   MemberStatusRemoved,
+	})
+}
+
+// parseMemberResponse parses a response wrapping a single member
+func parseMemberResponse(path string, data []byte) (*Member, error) {
+	var response struct {
+		Member Member `json:"member"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, wrapError(path, 0, "failed to parse member response", err)
+	}
+
+	return &response.Member, nil
+}
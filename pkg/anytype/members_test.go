@@ -0,0 +1,113 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// TestGetMember tests retrieving a single member with typed role and status
+func TestGetMember(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"member": {"id": "member456", "name": "Alice", "role": "editor", "status": "joining"}}`, &requests)
+	defer server.Close()
+
+	member, err := client.GetMember(context.Background(), "space123", "member456")
+	if err != nil {
+		t.Fatalf("GetMember failed: %v", err)
+	}
+	if member.Role != MemberRoleEditor || member.Status != MemberStatusJoining {
+		t.Errorf("Unexpected member: %+v", member)
+	}
+	if len(requests) != 1 || requests[0].Method != http.MethodGet || requests[0].Path != "/v1/spaces/space123/members/member456" {
+		t.Errorf("Unexpected requests: %+v", requests)
+	}
+
+	if _, err := client.GetMember(context.Background(), "space123", ""); !errors.Is(err, ErrMissingRequired) {
+		t.Errorf("Expected ErrMissingRequired for a missing member ID, got %v", err)
+	}
+}
+
+// TestMemberManagement tests the requests sent to approve, decline, update and remove members
+func TestMemberManagement(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"member": {"id": "member456", "status": "active"}}`, &requests)
+	defer server.Close()
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func() (*Member, error)
+		body map[string]interface{}
+	}{
+		{
+			name: "approve",
+			call: func() (*Member, error) {
+				return client.ApproveMember(ctx, "space123", "member456", MemberRoleEditor)
+			},
+			body: map[string]interface{}{"status": "active", "role": "editor"},
+		},
+		{
+			name: "decline",
+			call: func() (*Member, error) {
+				return client.DeclineMember(ctx, "space123", "member456")
+			},
+			body: map[string]interface{}{"status": "declined"},
+		},
+		{
+			name: "role",
+			call: func() (*Member, error) {
+				return client.UpdateMemberRole(ctx, "space123", "member456", MemberRoleViewer)
+			},
+			body: map[string]interface{}{"role": "viewer"},
+		},
+		{
+			name: "remove",
+			call: func() (*Member, error) {
+				return client.RemoveMember(ctx, "space123", "member456")
+			},
+			body: map[string]interface{}{"status": "removed"},
+		},
+	}
+
+	for _, tt := range tests {
+		requests = nil
+		if _, err := tt.call(); err != nil {
+			t.Errorf("%s failed: %v", tt.name, err)
+			continue
+		}
+		if len(requests) != 1 || requests[0].Method != http.MethodPatch || requests[0].Path != "/v1/spaces/space123/members/member456" {
+			t.Errorf("%s: unexpected requests: %+v", tt.name, requests)
+			continue
+		}
+		if len(requests[0].Body) != len(tt.body) {
+			t.Errorf("%s: expected body %v, got %v", tt.name, tt.body, requests[0].Body)
+		}
+		for key, value := range tt.body {
+			if requests[0].Body[key] != value {
+				t.Errorf("%s: expected body %v, got %v", tt.name, tt.body, requests[0].Body)
+			}
+		}
+	}
+
+	// Invalid updates are rejected before any request
+	requests = nil
+	invalid := []*UpdateMemberParams{
+		{SpaceID: "space123", MemberID: "member456"},
+		{SpaceID: "space123", MemberID: "member456", Status: MemberStatusJoining},
+		{SpaceID: "space123", MemberID: "member456", Role: "admin"},
+		{SpaceID: "space123", Status: MemberStatusActive},
+	}
+	for _, params := range invalid {
+		if _, err := client.UpdateMember(ctx, params); err == nil {
+			t.Errorf("Expected an error for %+v", params)
+		}
+	}
+	if _, err := client.UpdateMemberRole(ctx, "space123", "member456", ""); !errors.Is(err, ErrMissingRequired) {
+		t.Errorf("Expected ErrMissingRequired for a missing role, got %v", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("Expected invalid updates not to be sent, got %+v", requests)
+	}
+}
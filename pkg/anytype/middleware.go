@@ -0,0 +1,156 @@
+package anytype
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// RoundTripFunc performs a single HTTP request and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add behavior around API requests.
+//
+// Middlewares can modify the request before calling next, inspect or replace
+// the response, or short-circuit the request entirely. They are called once
+// per attempt, so retried requests pass through the chain again.
+//
+// Example:
+//
+//	// Add a custom header to every request
+//	withTenant := func(next anytype.RoundTripFunc) anytype.RoundTripFunc {
+//	    return func(req *http.Request) (*http.Response, error) {
+//	        req.Header.Set("X-Tenant", "acme")
+//	        return next(req)
+//	    }
+//	}
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware adds middlewares to the client's request chain.
+//
+// Middlewares are called in the order they are added: the first middleware
+// sees the request first and the response last. Built-in middlewares, such as
+// curl printing and debug response logging, run after all custom middlewares
+// so they observe the final request.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithMiddleware(auditLog, signRequest),
+//	)
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) {
+		for _, mw := range middlewares {
+			if mw != nil {
+				c.middlewares = append(c.middlewares, mw)
+			}
+		}
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to make requests.
+//
+// The client is copied, so options applied afterwards, such as WithTimeout,
+// don't modify the provided client.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithHTTPClient(&http.Client{Timeout: time.Minute}),
+//	)
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient == nil {
+			return
+		}
+		clientCopy := *httpClient
+		c.httpClient = &clientCopy
+	}
+}
+
+// WithTransport sets the HTTP transport used to make requests.
+//
+// Use this to route requests through a proxy or to customize connection
+// pooling and TLS settings while keeping the client's timeout.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithTransport(&http.Transport{MaxIdleConnsPerHost: 10}),
+//	)
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// buildRoundTrip assembles the request chain from the configured middlewares
+func (c *Client) buildRoundTrip() RoundTripFunc {
+	if c.transport != nil {
+		clientCopy := *c.httpClient
+		clientCopy.Transport = c.transport
+		c.httpClient = &clientCopy
+	}
+
+	httpClient := c.httpClient
+	roundTrip := RoundTripFunc(httpClient.Do)
+
+	// Built-in middlewares sit closest to the transport
+	if c.debug && c.logger != nil {
+		roundTrip = c.debugResponseMiddleware(roundTrip)
+	}
+	if c.debug || c.printCurl {
+		roundTrip = c.curlMiddleware(roundTrip)
+	}
+
+	// Wrap in reverse order so the first middleware is the outermost one
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		roundTrip = c.middlewares[i](roundTrip)
+	}
+
+	return roundTrip
+}
+
+// curlMiddleware prints a curl command equivalent to each request
+func (c *Client) curlMiddleware(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		var body []byte
+		if req.GetBody != nil {
+			if reader, err := req.GetBody(); err == nil {
+				body, _ = io.ReadAll(reader)
+				reader.Close()
+			}
+		}
+
+		c.printCurlRequest(req.Method, req.URL.String(), req.Header, body)
+		return next(req)
+	}
+}
+
+// debugResponseMiddleware logs the body of each response
+func (c *Client) debugResponseMiddleware(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		resp, err := next(req)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		c.logger.Debug("Response: %s", string(data))
+
+		// Replace the consumed body so the caller can read it again
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		return resp, nil
+	}
+}
@@ -0,0 +1,194 @@
+package anytype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/epheo/anytype-go/internal/log"
+)
+
+// testLogger records debug messages for assertions
+type testLogger struct {
+	mu       sync.Mutex
+	level    log.Level
+	messages []string
+}
+
+func (l *testLogger) Error(format string, args ...interface{}) { l.record(format, args...) }
+func (l *testLogger) Info(format string, args ...interface{})  { l.record(format, args...) }
+func (l *testLogger) Debug(format string, args ...interface{}) { l.record(format, args...) }
+func (l *testLogger) SetLevel(level log.Level)                 { l.level = level }
+func (l *testLogger) GetLevel() log.Level                      { return l.level }
+
+func (l *testLogger) record(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) contains(substr string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, msg := range l.messages {
+		if strings.Contains(msg, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestMiddlewareOrder tests that middlewares run in the order they were added
+func TestMiddlewareOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Trace"); got != "first,second" {
+			t.Errorf("Expected X-Trace header 'first,second', got %q", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"space": {"id": "space123"}}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				if existing := req.Header.Get("X-Trace"); existing != "" {
+					req.Header.Set("X-Trace", existing+","+name)
+				} else {
+					req.Header.Set("X-Trace", name)
+				}
+				order = append(order, "before "+name)
+				resp, err := next(req)
+				order = append(order, "after "+name)
+				return resp, err
+			}
+		}
+	}
+
+	client, err := NewClient(
+		WithURL(server.URL),
+		WithAppKey("test-app-key"),
+		WithMiddleware(trace("first"), trace("second")),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.GetSpaceByID(context.Background(), "space123"); err != nil {
+		t.Fatalf("GetSpaceByID failed: %v", err)
+	}
+
+	expected := "before first,before second,after second,after first"
+	if got := strings.Join(order, ","); got != expected {
+		t.Fatalf("Expected middleware order %q, got %q", expected, got)
+	}
+}
+
+// TestMiddlewareShortCircuit tests that a middleware can answer without calling the server
+func TestMiddlewareShortCircuit(t *testing.T) {
+	stub := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(http.StatusNotFound)
+			return rec.Result(), nil
+		}
+	}
+
+	client, err := NewClient(
+		WithURL("http://invalid.invalid"),
+		WithAppKey("test-app-key"),
+		WithMiddleware(stub),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.GetSpaceByID(context.Background(), "space123")
+	if !IsNotFoundError(err) {
+		t.Fatalf("Expected a not found error, got: %v", err)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// TestWithTransport tests that a custom transport is used for requests
+func TestWithTransport(t *testing.T) {
+	var used bool
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		used = true
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(http.StatusOK)
+		rec.WriteString(`{"space": {"id": "space123"}}`)
+		return rec.Result(), nil
+	})
+
+	httpClient := &http.Client{}
+	client, err := NewClient(
+		WithAppKey("test-app-key"),
+		WithHTTPClient(httpClient),
+		WithTransport(transport),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.GetSpaceByID(context.Background(), "space123"); err != nil {
+		t.Fatalf("GetSpaceByID failed: %v", err)
+	}
+	if !used {
+		t.Fatal("Expected the custom transport to be used")
+	}
+	if httpClient.Transport != nil {
+		t.Fatal("The provided HTTP client should not be modified")
+	}
+}
+
+// TestBuiltinDebugMiddlewares tests that curl printing and response logging run through the chain
+func TestBuiltinDebugMiddlewares(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"space": {"id": "space123"}}`))
+	}))
+	defer server.Close()
+
+	signer := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Signature", "signed")
+			return next(req)
+		}
+	}
+
+	logger := &testLogger{}
+	client, err := NewClient(
+		WithURL(server.URL),
+		WithAppKey("test-app-key"),
+		WithLogger(logger),
+		WithDebug(true),
+		WithMiddleware(signer),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	space, err := client.GetSpaceByID(context.Background(), "space123")
+	if err != nil {
+		t.Fatalf("GetSpaceByID failed: %v", err)
+	}
+	if space.ID != "space123" {
+		t.Fatalf("Response body was not preserved: %+v", space)
+	}
+	if !logger.contains("X-Signature: signed") {
+		t.Fatal("Expected curl output to include headers set by custom middlewares")
+	}
+	if !logger.contains(`Response: {"space": {"id": "space123"}}`) {
+		t.Fatal("Expected the response body to be logged")
+	}
+}
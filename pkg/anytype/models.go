@@ -2,6 +2,8 @@ package anytype
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -74,13 +76,26 @@ type FileBlock struct {
 // TypeInfo represents a type in Anytype
 // Matches the object.Type schema in the API documentation
 type TypeInfo struct {
-	Object            string `json:"object,omitempty"`             // Data model, always "type"
-	ID                string `json:"id,omitempty"`                 // Unique ID of the type
-	Key               string `json:"key,omitempty"`                // Consistent key across spaces (e.g., "ot-page")
-	Name              string `json:"name,omitempty"`               // Display name of the type
-	Icon              *Icon  `json:"icon,omitempty"`               // Type icon
-	Archived          bool   `json:"archived,omitempty"`           // Whether the type is archived
-	RecommendedLayout string `json:"recommended_layout,omitempty"` // Recommended layout for this type
+	Object            string               `json:"object,omitempty"`             // Data model, always "type"
+	ID                string               `json:"id,omitempty"`                 // Unique ID of the type
+	Key               string               `json:"key,omitempty"`                // Consistent key across spaces (e.g., "ot-page")
+	Name              string               `json:"name,omitempty"`               // Display name of the type
+	PluralName        string               `json:"plural_name,omitempty"`        // Plural display name of the type
+	Icon              *Icon                `json:"icon,omitempty"`               // Type icon
+	Archived          bool                 `json:"archived,omitempty"`           // Whether the type is archived
+	Layout            TypeLayout           `json:"layout,omitempty"`             // Layout of objects of this type
+	RecommendedLayout string               `json:"recommended_layout,omitempty"` // Recommended layout for this type
+	Properties        []PropertyDefinition `json:"properties,omitempty"`         // Properties of objects of this type
+}
+
+// PropertyDefinition describes a property of a type
+// Matches the object.Property schema in the API documentation
+type PropertyDefinition struct {
+	Object string         `json:"object,omitempty"` // Data model, always "property"
+	ID     string         `json:"id,omitempty"`     // Unique ID of the property
+	Key    string         `json:"key,omitempty"`    // Consistent key of the property (e.g., "due_date")
+	Name   string         `json:"name,omitempty"`   // Display name of the property
+	Format PropertyFormat `json:"format,omitempty"` // Format of the property values
 }
 
 // Response types
@@ -128,19 +143,20 @@ type (
 		CanLeave          bool     `json:"can_leave,omitempty"`           // Whether the space can be left
 		Role              string   `json:"role,omitempty"`                // User's role in the space
 		Members           []Member `json:"-"`                             // Members populated separately
+		MembersErr        error    `json:"-"`                             // Error that prevented populating Members, if any
 	}
 
 	// Member represents a member of a space
 	// Matches the space.Member schema in the API documentation
 	Member struct {
-		Object     string `json:"object,omitempty"`      // Data model, e.g. "member"
-		ID         string `json:"id,omitempty"`          // Member ID
-		Name       string `json:"name,omitempty"`        // Member name
-		Icon       *Icon  `json:"icon,omitempty"`        // Member icon
-		Identity   string `json:"identity,omitempty"`    // Network identity
-		GlobalName string `json:"global_name,omitempty"` // Global name in network
-		Role       string `json:"role,omitempty"`        // Role: viewer, editor, owner, no_permission
-		Status     string `json:"status,omitempty"`      // Status: joining, active, removed, declined, removing, canceled
+		Object     string       `json:"object,omitempty"`      // Data model, e.g. "member"
+		ID         string       `json:"id,omitempty"`          // Member ID
+		Name       string       `json:"name,omitempty"`        // Member name
+		Icon       *Icon        `json:"icon,omitempty"`        // Member icon
+		Identity   string       `json:"identity,omitempty"`    // Network identity
+		GlobalName string       `json:"global_name,omitempty"` // Global name in network
+		Role       MemberRole   `json:"role,omitempty"`        // Role: viewer, editor, owner, no_permission
+		Status     MemberStatus `json:"status,omitempty"`      // Status: joining, active, removed, declined, removing, canceled
 	}
 
 	// MembersResponse represents the response from the members endpoint
@@ -170,6 +186,7 @@ type (
 	// Matches the object.Tag schema
 	PropertyTag struct {
 		ID    string `json:"id,omitempty"`    // Tag ID
+		Key   string `json:"key,omitempty"`   // Tag key, consistent across spaces
 		Name  string `json:"name,omitempty"`  // Tag name
 		Color string `json:"color,omitempty"` // Tag color
 	}
@@ -178,6 +195,7 @@ type (
 	// Matches the object.Property schema
 	Property struct {
 		ID          string        `json:"id,omitempty"`           // Property ID
+		Key         string        `json:"key,omitempty"`          // Property key, consistent across spaces
 		Name        string        `json:"name,omitempty"`         // Property name
 		Format      string        `json:"format,omitempty"`       // Property format type
 		MultiSelect []PropertyTag `json:"multi_select,omitempty"` // Multi-select values
@@ -191,6 +209,8 @@ type (
 		Checkbox    bool          `json:"checkbox,omitempty"`     // Checkbox value
 		File        []string      `json:"file,omitempty"`         // File references
 		Select      *PropertyTag  `json:"select,omitempty"`       // Single select value
+
+		explicit bool // Whether an empty value is written, set by the typed setters and ClearProperty
 	}
 
 	// Relation represents a relation to another object
@@ -228,20 +248,25 @@ type (
 	// SearchParams represents search parameters
 	// Matches the search.SearchRequest schema
 	SearchParams struct {
-		SpaceID string       `json:"space_id,omitempty"` // Space ID to search in
-		Query   string       `json:"query,omitempty"`    // Search term
-		Types   []string     `json:"types,omitempty"`    // Object types to include
-		Tags    []string     `json:"tags,omitempty"`     // Tags to filter by (client-side)
-		Sort    *SortOptions `json:"sort,omitempty"`     // Sorting options
-		Limit   int          `json:"limit,omitempty"`    // Result limit
-		Offset  int          `json:"offset,omitempty"`   // Result offset
+		SpaceID     string            `json:"space_id,omitempty"`     // Space ID to search in
+		Query       string            `json:"query,omitempty"`        // Search term
+		Types       []string          `json:"types,omitempty"`        // Object types to include
+		Tags        []string          `json:"tags,omitempty"`         // Tags to filter by, matching any of them
+		AllTags     []string          `json:"all_tags,omitempty"`     // Tags that objects must all have
+		ExcludeTags []string          `json:"exclude_tags,omitempty"` // Tags that objects must not have
+		TagIDs      []string          `json:"tag_ids,omitempty"`      // Tag IDs to filter by, matching any of them
+		Filters     *FilterExpression `json:"filters,omitempty"`      // Property filters
+		Sort        *SortOptions      `json:"sort,omitempty"`         // Primary sorting option
+		Sorts       []SortOptions     `json:"sorts,omitempty"`        // Sorting options in order of precedence, after Sort
+		Limit       int               `json:"limit,omitempty"`        // Result limit
+		Offset      int               `json:"offset,omitempty"`       // Result offset
 	}
 
 	// SortOptions represents sorting criteria for search results
 	// Matches the search.SortOptions schema
 	SortOptions struct {
-		Property  string `json:"property,omitempty"`  // Property to sort by
-		Direction string `json:"direction,omitempty"` // Sort direction (asc or desc)
+		Property  SortProperty  `json:"property,omitempty"`  // Property to sort by
+		Direction SortDirection `json:"direction,omitempty"` // Sort direction (asc or desc)
 	}
 
 	// SearchResponse represents the response from search endpoints
@@ -336,6 +361,44 @@ func NewGetSpacesParams() *GetSpacesParams {
 	}
 }
 
+// CreateSpaceParams represents parameters for creating a space
+type CreateSpaceParams struct {
+	Name        string `json:"name"`                  // Name of the space
+	Description string `json:"description,omitempty"` // Description of the space
+	Icon        *Icon  `json:"icon,omitempty"`        // Space icon
+}
+
+// Validate validates CreateSpaceParams fields
+func (p *CreateSpaceParams) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("space name is required: %w", ErrMissingRequired)
+	}
+	return nil
+}
+
+// UpdateSpaceParams represents parameters for updating a space.
+// Only the fields that are set are updated.
+type UpdateSpaceParams struct {
+	SpaceID     string  `json:"-"`                     // Space ID to update
+	Name        *string `json:"name,omitempty"`        // New name of the space
+	Description *string `json:"description,omitempty"` // New description of the space
+	Icon        *Icon   `json:"icon,omitempty"`        // New space icon
+}
+
+// Validate validates UpdateSpaceParams fields
+func (p *UpdateSpaceParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.Name == nil && p.Description == nil && p.Icon == nil {
+		return fmt.Errorf("nothing to update: %w", ErrMissingRequired)
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		return fmt.Errorf("space name cannot be empty: %w", ErrInvalidParameter)
+	}
+	return nil
+}
+
 // GetSpaceByIDParams represents parameters for retrieving a specific space
 type GetSpaceByIDParams struct {
 	SpaceID string `json:"space_id"` // Space ID to retrieve
@@ -351,7 +414,9 @@ func (p *GetSpaceByIDParams) Validate() error {
 
 // GetTypesParams represents parameters for retrieving types from a space
 type GetTypesParams struct {
-	SpaceID string `json:"space_id"` // Space ID to get types from
+	SpaceID string `json:"space_id"`         // Space ID to get types from
+	Offset  int    `json:"offset,omitempty"` // Number of types to skip
+	Limit   int    `json:"limit,omitempty"`  // Maximum number of types to return, 0 for all
 }
 
 // Validate validates GetTypesParams fields
@@ -359,6 +424,9 @@ func (p *GetTypesParams) Validate() error {
 	if p.SpaceID == "" {
 		return ErrInvalidSpaceID
 	}
+	if p.Offset < 0 || p.Limit < 0 {
+		return ErrInvalidParameter
+	}
 	return nil
 }
 
@@ -416,6 +484,11 @@ func (p *SearchParams) Validate() error {
 	if p.Limit < 0 || p.Offset < 0 {
 		return ErrInvalidParameter
 	}
+	for _, sort := range p.sortKeys() {
+		if err := sort.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,308 @@
+package anytype
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Typed property accessors.
+//
+// The getters return the value of a property together with whether the object
+// has the property: a false checkbox or a zero number that is set on the
+// object is reported as present, while a missing property is not. A property
+// with a different format than the one requested is reported as missing.
+//
+// The setters replace the value of the property with the given key, keeping
+// its ID and name, or add the property if the object doesn't have it yet.
+// They also set the format of the property and mark its value as set, so that
+// zero values are written to the API instead of being dropped.
+
+// HasProperty reports whether the object has a property with the given key
+func (o *Object) HasProperty(key string) bool {
+	return o.propertyIndex(key) >= 0
+}
+
+// GetProperty returns the property with the given key.
+// Properties without a key are matched by name.
+func (o *Object) GetProperty(key string) (Property, bool) {
+	if i := o.propertyIndex(key); i >= 0 {
+		return o.Properties[i], true
+	}
+	return Property{}, false
+}
+
+// RemoveProperty removes the property with the given key from the object,
+// reporting whether it was present
+func (o *Object) RemoveProperty(key string) bool {
+	i := o.propertyIndex(key)
+	if i < 0 {
+		return false
+	}
+	o.Properties = append(o.Properties[:i], o.Properties[i+1:]...)
+	return true
+}
+
+// GetText returns the value of a text property
+func (o *Object) GetText(key string) (string, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatText)
+	return prop.Text, ok
+}
+
+// GetNumber returns the value of a number property
+func (o *Object) GetNumber(key string) (float64, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatNumber)
+	return prop.Number, ok
+}
+
+// GetDate returns the value of a date property.
+// Dates that are empty or can't be parsed are reported as missing.
+func (o *Object) GetDate(key string) (time.Time, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatDate)
+	if !ok {
+		return time.Time{}, false
+	}
+	return parseFilterDate(prop.Date)
+}
+
+// GetCheckbox returns the value of a checkbox property
+func (o *Object) GetCheckbox(key string) (bool, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatCheckbox)
+	return prop.Checkbox, ok
+}
+
+// GetSelect returns the selected tag of a select property.
+// A select property without a selected tag is reported as missing.
+func (o *Object) GetSelect(key string) (PropertyTag, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatSelect)
+	if !ok || prop.Select == nil {
+		return PropertyTag{}, false
+	}
+	return *prop.Select, true
+}
+
+// GetMultiSelect returns the selected tags of a multi_select property
+func (o *Object) GetMultiSelect(key string) ([]PropertyTag, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatMultiSelect)
+	return prop.MultiSelect, ok
+}
+
+// GetObjects returns the IDs of the objects referenced by an objects property
+func (o *Object) GetObjects(key string) ([]string, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatObjects)
+	return prop.Object, ok
+}
+
+// GetFiles returns the IDs of the files of a files property
+func (o *Object) GetFiles(key string) ([]string, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatFiles)
+	return prop.File, ok
+}
+
+// GetURL returns the value of a url property
+func (o *Object) GetURL(key string) (string, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatURL)
+	return prop.URL, ok
+}
+
+// GetEmail returns the value of an email property
+func (o *Object) GetEmail(key string) (string, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatEmail)
+	return prop.Email, ok
+}
+
+// GetPhone returns the value of a phone property
+func (o *Object) GetPhone(key string) (string, bool) {
+	prop, ok := o.typedProperty(key, PropertyFormatPhone)
+	return prop.Phone, ok
+}
+
+// SetText sets the value of a text property
+func (o *Object) SetText(key, value string) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatText), Text: value})
+}
+
+// SetNumber sets the value of a number property
+func (o *Object) SetNumber(key string, value float64) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatNumber), Number: value})
+}
+
+// SetDate sets the value of a date property, formatted as RFC 3339
+func (o *Object) SetDate(key string, value time.Time) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatDate), Date: value.Format(time.RFC3339)})
+}
+
+// SetCheckbox sets the value of a checkbox property
+func (o *Object) SetCheckbox(key string, value bool) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatCheckbox), Checkbox: value})
+}
+
+// SetSelect sets the selected tag of a select property
+func (o *Object) SetSelect(key string, tag PropertyTag) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatSelect), Select: &tag})
+}
+
+// SetMultiSelect sets the selected tags of a multi_select property
+func (o *Object) SetMultiSelect(key string, tags ...PropertyTag) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatMultiSelect), MultiSelect: tags})
+}
+
+// SetObjects sets the IDs of the objects referenced by an objects property
+func (o *Object) SetObjects(key string, objectIDs ...string) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatObjects), Object: objectIDs})
+}
+
+// SetFiles sets the IDs of the files of a files property
+func (o *Object) SetFiles(key string, fileIDs ...string) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatFiles), File: fileIDs})
+}
+
+// SetURL sets the value of a url property
+func (o *Object) SetURL(key, value string) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatURL), URL: value})
+}
+
+// SetEmail sets the value of an email property
+func (o *Object) SetEmail(key, value string) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatEmail), Email: value})
+}
+
+// SetPhone sets the value of a phone property
+func (o *Object) SetPhone(key, value string) {
+	o.setProperty(Property{Key: key, Format: string(PropertyFormatPhone), Phone: value})
+}
+
+// ClearProperty sets a property to its empty value: an empty text, a zero
+// number, an unchecked checkbox, or no date, tags, objects or files.
+//
+// Unlike RemoveProperty, the property is kept on the object, so that the
+// empty value is written to the API.
+func (o *Object) ClearProperty(key string, format PropertyFormat) {
+	o.setProperty(Property{Key: key, Format: string(format)})
+}
+
+// propertyIndex returns the index of the property with the given key, -1 if there is none
+func (o *Object) propertyIndex(key string) int {
+	for i, prop := range o.Properties {
+		if prop.Key == key {
+			return i
+		}
+	}
+	for i, prop := range o.Properties {
+		if prop.Key == "" && strings.EqualFold(prop.Name, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// typedProperty returns the property with the given key if it has the given format.
+// Properties without a format are accepted when they hold a value of that format.
+func (o *Object) typedProperty(key string, format PropertyFormat) (Property, bool) {
+	prop, ok := o.GetProperty(key)
+	if !ok {
+		return Property{}, false
+	}
+	if prop.Format != "" {
+		if prop.Format != string(format) {
+			return Property{}, false
+		}
+		return prop, true
+	}
+	for _, valueFormat := range valueFormats(prop) {
+		if valueFormat == format {
+			return prop, true
+		}
+	}
+	return Property{}, false
+}
+
+// setProperty replaces the property with the same key, keeping its ID and name, or adds it.
+// The value of the property is written even when empty.
+func (o *Object) setProperty(prop Property) {
+	prop.explicit = true
+	i := o.propertyIndex(prop.Key)
+	if i < 0 {
+		o.Properties = append(o.Properties, prop)
+		return
+	}
+	prop.ID = o.Properties[i].ID
+	prop.Name = o.Properties[i].Name
+	if o.Properties[i].Key != "" {
+		prop.Key = o.Properties[i].Key
+	}
+	o.Properties[i] = prop
+}
+
+// MarshalJSON implements custom JSON marshaling for Property.
+//
+// For properties set with the typed setters of Object or cleared with
+// ClearProperty, the value matching the format of the property is always
+// written, even when it is empty, so that unchecked checkboxes, zero numbers
+// and cleared values reach the API. Empty dates and selects are then written
+// as null and empty lists as []. Other properties, such as the ones read from
+// the API, only include the values that are set.
+func (p Property) MarshalJSON() ([]byte, error) {
+	type alias Property
+	data, err := json.Marshal(alias(p))
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.explicit {
+		return data, nil
+	}
+	field, value, ok := p.formatValue()
+	if !ok {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if fields[field], err = json.Marshal(value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// formatValue returns the JSON field and the value matching the format of the property
+func (p Property) formatValue() (string, interface{}, bool) {
+	switch PropertyFormat(p.Format) {
+	case PropertyFormatText:
+		return "text", p.Text, true
+	case PropertyFormatNumber:
+		return "number", p.Number, true
+	case PropertyFormatSelect:
+		return "select", p.Select, true
+	case PropertyFormatMultiSelect:
+		return "multi_select", nonNil(p.MultiSelect), true
+	case PropertyFormatDate:
+		if p.Date == "" {
+			return "date", nil, true
+		}
+		return "date", p.Date, true
+	case PropertyFormatFiles:
+		return "file", nonNil(p.File), true
+	case PropertyFormatCheckbox:
+		return "checkbox", p.Checkbox, true
+	case PropertyFormatURL:
+		return "url", p.URL, true
+	case PropertyFormatEmail:
+		return "email", p.Email, true
+	case PropertyFormatPhone:
+		return "phone", p.Phone, true
+	case PropertyFormatObjects:
+		return "object", nonNil(p.Object), true
+	}
+	return "", nil, false
+}
+
+// nonNil returns an empty slice instead of nil, so that it is written as [] in JSON
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
@@ -0,0 +1,200 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestObjectPropertyGetters tests the typed getters and their present vs zero semantics
+func TestObjectPropertyGetters(t *testing.T) {
+	obj := &Object{Properties: []Property{
+		{Key: "summary", Format: "text", Text: "Database outage"},
+		{Key: "impact", Format: "number"},
+		{Key: "resolved", Format: "checkbox"},
+		{Key: "due_date", Format: "date", Date: "2024-03-01T10:00:00Z"},
+		{Key: "severity", Format: "select", Select: &PropertyTag{ID: "tag1", Name: "SEV1"}},
+		{Key: "services", Format: "multi_select", MultiSelect: []PropertyTag{{Name: "api"}, {Name: "db"}}},
+		{Key: "related", Format: "objects", Object: []string{"obj1"}},
+		{Name: "Runbook", URL: "https://example.com/runbook"},
+	}}
+
+	if text, ok := obj.GetText("summary"); !ok || text != "Database outage" {
+		t.Errorf("Expected summary text, got %q (%v)", text, ok)
+	}
+	if number, ok := obj.GetNumber("impact"); !ok || number != 0 {
+		t.Errorf("Expected a present zero impact, got %v (%v)", number, ok)
+	}
+	if checked, ok := obj.GetCheckbox("resolved"); !ok || checked {
+		t.Errorf("Expected a present unchecked checkbox, got %v (%v)", checked, ok)
+	}
+	if date, ok := obj.GetDate("due_date"); !ok || !date.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected due date, got %v (%v)", date, ok)
+	}
+	if tag, ok := obj.GetSelect("severity"); !ok || tag.Name != "SEV1" {
+		t.Errorf("Expected severity SEV1, got %+v (%v)", tag, ok)
+	}
+	if tags, ok := obj.GetMultiSelect("services"); !ok || len(tags) != 2 {
+		t.Errorf("Expected 2 services, got %+v (%v)", tags, ok)
+	}
+	if ids, ok := obj.GetObjects("related"); !ok || len(ids) != 1 || ids[0] != "obj1" {
+		t.Errorf("Expected related objects, got %v (%v)", ids, ok)
+	}
+
+	// Properties without a key are matched by name, and without a format by value
+	if url, ok := obj.GetURL("runbook"); !ok || url != "https://example.com/runbook" {
+		t.Errorf("Expected runbook URL, got %q (%v)", url, ok)
+	}
+
+	// Missing properties and format mismatches are reported as missing
+	if _, ok := obj.GetNumber("missing"); ok {
+		t.Error("Expected a missing property to be reported as missing")
+	}
+	if text, ok := obj.GetText("impact"); ok || text != "" {
+		t.Errorf("Expected a number property not to be read as text, got %q (%v)", text, ok)
+	}
+	if !obj.HasProperty("impact") || obj.HasProperty("missing") {
+		t.Error("Unexpected HasProperty result")
+	}
+}
+
+// TestObjectPropertySetters tests that setters replace existing properties and add missing ones
+func TestObjectPropertySetters(t *testing.T) {
+	obj := &Object{Properties: []Property{
+		{ID: "prop1", Key: "resolved", Name: "Resolved", Format: "checkbox", Checkbox: true},
+	}}
+
+	obj.SetCheckbox("resolved", false)
+	obj.SetNumber("impact", 0)
+	obj.SetDate("due_date", time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	obj.SetSelect("severity", PropertyTag{ID: "tag1"})
+	obj.SetText("summary", "first")
+	obj.SetText("summary", "second")
+
+	if len(obj.Properties) != 5 {
+		t.Fatalf("Expected 5 properties, got %+v", obj.Properties)
+	}
+	if prop := obj.Properties[0]; prop.ID != "prop1" || prop.Name != "Resolved" || prop.Checkbox {
+		t.Errorf("Expected the checkbox to be unchecked in place, got %+v", prop)
+	}
+	if checked, ok := obj.GetCheckbox("resolved"); !ok || checked {
+		t.Errorf("Expected a present unchecked checkbox, got %v (%v)", checked, ok)
+	}
+	if text, _ := obj.GetText("summary"); text != "second" {
+		t.Errorf("Expected summary to be replaced, got %q", text)
+	}
+	if prop, _ := obj.GetProperty("due_date"); prop.Date != "2024-03-01T10:00:00Z" || prop.Format != "date" {
+		t.Errorf("Unexpected date property: %+v", prop)
+	}
+
+	obj.ClearProperty("severity", PropertyFormatSelect)
+	if _, ok := obj.GetSelect("severity"); ok || !obj.HasProperty("severity") {
+		t.Error("Expected the select property to be kept without a tag")
+	}
+	if !obj.RemoveProperty("severity") || obj.HasProperty("severity") || obj.RemoveProperty("severity") {
+		t.Error("Expected the select property to be removed once")
+	}
+}
+
+// TestPropertyMarshalJSON tests that zero values are written for properties set with the setters
+func TestPropertyMarshalJSON(t *testing.T) {
+	obj := &Object{}
+	obj.SetCheckbox("resolved", false)
+	obj.SetNumber("impact", 0)
+	obj.ClearProperty("severity", PropertyFormatSelect)
+	obj.ClearProperty("due_date", PropertyFormatDate)
+	obj.ClearProperty("services", PropertyFormatMultiSelect)
+
+	data, err := json.Marshal(obj.Properties)
+	if err != nil {
+		t.Fatalf("Failed to marshal properties: %v", err)
+	}
+	var properties []map[string]interface{}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		t.Fatalf("Failed to unmarshal properties: %v", err)
+	}
+
+	expected := []struct {
+		field string
+		value interface{}
+	}{
+		{"checkbox", false},
+		{"number", float64(0)},
+		{"select", nil},
+		{"date", nil},
+		{"multi_select", []interface{}{}},
+	}
+	for i, want := range expected {
+		value, ok := properties[i][want.field]
+		if !ok {
+			t.Errorf("Expected %s to be written, got %v", want.field, properties[i])
+			continue
+		}
+		if got, _ := json.Marshal(value); string(got) != mustJSON(t, want.value) {
+			t.Errorf("Expected %s to be %s, got %s", want.field, mustJSON(t, want.value), got)
+		}
+		if len(properties[i]) != 3 {
+			t.Errorf("Expected only key, format and %s, got %v", want.field, properties[i])
+		}
+	}
+
+	// Other properties keep omitting empty values
+	data, err = json.Marshal(Property{Key: "legacy"})
+	if err != nil {
+		t.Fatalf("Failed to marshal property: %v", err)
+	}
+	if string(data) != `{"key":"legacy"}` {
+		t.Errorf("Unexpected legacy property JSON: %s", data)
+	}
+}
+
+// mustJSON returns the JSON encoding of a value
+func mustJSON(t *testing.T, value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Failed to marshal %v: %v", value, err)
+	}
+	return string(data)
+}
+
+// TestUpdateObjectUnsetProperties tests that properties read from the API are sent back unchanged
+func TestUpdateObjectUnsetProperties(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"object": {"id": "obj456"}}`, &requests)
+	defer server.Close()
+
+	fetched := `[{"key":"done","format":"checkbox"},{"key":"due_date","format":"date"},{"key":"owner","format":"select"},{"key":"priority","format":"number","number":2}]`
+	obj := &Object{ID: "obj456", Name: "Task"}
+	if err := json.Unmarshal([]byte(fetched), &obj.Properties); err != nil {
+		t.Fatalf("Failed to unmarshal properties: %v", err)
+	}
+	if _, err := client.UpdateObject(context.Background(), "space123", "obj456", obj); err != nil {
+		t.Fatalf("UpdateObject failed: %v", err)
+	}
+
+	// Only values set with the setters are written when empty
+	obj.SetCheckbox("done", false)
+	if _, err := client.UpdateObject(context.Background(), "space123", "obj456", obj); err != nil {
+		t.Fatalf("UpdateObject failed: %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %+v", requests)
+	}
+	var expected interface{}
+	json.Unmarshal([]byte(fetched), &expected)
+	if sent := mustJSON(t, requests[0].Body["properties"]); sent != mustJSON(t, expected) {
+		t.Errorf("Expected the properties to be sent unchanged:\n%s\ngot:\n%s", fetched, sent)
+	}
+	properties, _ := requests[1].Body["properties"].([]interface{})
+	if len(properties) != 4 {
+		t.Fatalf("Expected 4 properties, got %v", requests[1].Body["properties"])
+	}
+	if checkbox, ok := properties[0].(map[string]interface{})["checkbox"]; !ok || checkbox != false {
+		t.Errorf("Expected done to be sent as false once set, got %v", properties[0])
+	}
+	if _, ok := properties[1].(map[string]interface{})["date"]; ok {
+		t.Errorf("Expected the unset date to be left out, got %v", properties[1])
+	}
+}
@@ -0,0 +1,228 @@
+package anytype
+
+import (
+	"context"
+)
+
+// defaultPageSize is the number of items requested per page by pagers
+const defaultPageSize = 100
+
+// PageFunc fetches a single page of items starting at offset.
+//
+// It returns the items of the page and the pagination metadata reported by the API.
+type PageFunc[T any] func(ctx context.Context, offset, limit int) ([]T, Pagination, error)
+
+// Pager walks a paginated list endpoint page by page.
+//
+// A Pager requests pages of up to PageSize items, advancing the offset until
+// the API reports that no more items are available or the optional maximum
+// number of items is reached. A Pager is not safe for concurrent use.
+//
+// Example:
+//
+//	pager := client.SearchPager("space123", &anytype.SearchParams{Query: "meeting"}).
+//	    WithMaxItems(500)
+//
+//	for pager.HasNext() {
+//	    objects, err := pager.Next(ctx)
+//	    if err != nil {
+//	        log.Fatalf("Failed to fetch page: %v", err)
+//	    }
+//	    for _, obj := range objects {
+//	        fmt.Println(obj.Name)
+//	    }
+//	}
+type Pager[T any] struct {
+	fetch      PageFunc[T]
+	offset     int        // Offset of the next page
+	pageSize   int        // Number of items requested per page
+	maxItems   int        // Maximum number of items to return, 0 for no limit
+	returned   int        // Number of items returned so far
+	done       bool       // Whether all pages were fetched
+	pagination Pagination // Pagination metadata of the last page
+}
+
+// NewPager creates a Pager that fetches pages using fetch, starting at offset 0
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{
+		fetch:    fetch,
+		pageSize: defaultPageSize,
+	}
+}
+
+// WithPageSize sets the number of items requested per page
+func (p *Pager[T]) WithPageSize(size int) *Pager[T] {
+	if size > 0 {
+		p.pageSize = size
+	}
+	return p
+}
+
+// WithOffset sets the offset of the first page
+func (p *Pager[T]) WithOffset(offset int) *Pager[T] {
+	if offset >= 0 {
+		p.offset = offset
+	}
+	return p
+}
+
+// WithMaxItems caps the total number of items returned by the pager.
+// A value of 0 means no limit.
+func (p *Pager[T]) WithMaxItems(maxItems int) *Pager[T] {
+	if maxItems >= 0 {
+		p.maxItems = maxItems
+	}
+	return p
+}
+
+// HasNext reports whether another page may be available
+func (p *Pager[T]) HasNext() bool {
+	return !p.done
+}
+
+// Pagination returns the pagination metadata of the last fetched page
+func (p *Pager[T]) Pagination() Pagination {
+	return p.pagination
+}
+
+// Next fetches the next page of items.
+//
+// It returns an empty page once all pages were fetched.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	limit := p.pageSize
+	if p.maxItems > 0 && p.maxItems-p.returned < limit {
+		limit = p.maxItems - p.returned
+	}
+
+	items, pagination, err := p.fetch(ctx, p.offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	p.pagination = pagination
+
+	if p.maxItems > 0 && p.returned+len(items) > p.maxItems {
+		items = items[:p.maxItems-p.returned]
+	}
+
+	p.offset += len(items)
+	p.returned += len(items)
+
+	// Stop on the last page, on an empty page to avoid looping forever, or once the cap is reached
+	if !pagination.HasMore || len(items) == 0 || (p.maxItems > 0 && p.returned >= p.maxItems) {
+		p.done = true
+	}
+
+	return items, nil
+}
+
+// All fetches all remaining pages and returns their items
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.HasNext() {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, items...)
+	}
+	if all == nil {
+		all = []T{}
+	}
+	return all, nil
+}
+
+// ForEach fetches all remaining pages and calls fn for each item.
+//
+// Iteration stops at the first error returned by fn or by a page fetch.
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(item T) error) error {
+	for p.HasNext() {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SearchPager returns a Pager over all objects matching the search parameters.
+//
+// The pager starts at params.Offset and uses params.Limit as its page size.
+// When results are filtered or sorted client-side, each page continues
+// scanning the server results where the previous page stopped.
+func (c *Client) SearchPager(spaceID string, params *SearchParams) *Pager[Object] {
+	if params == nil {
+		params = NewSearchParams()
+	}
+
+	cursor := &searchCursor{}
+	fetch := func(ctx context.Context, offset, limit int) ([]Object, Pagination, error) {
+		pageParams := *params
+		pageParams.Offset = offset
+		pageParams.Limit = limit
+
+		if err := c.validateSearchParams(spaceID, &pageParams); err != nil {
+			return nil, Pagination{}, err
+		}
+		response, err := c.searchFrom(ctx, spaceID, &pageParams, cursor)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return response.Data, response.Pagination, nil
+	}
+
+	return NewPager(fetch).WithPageSize(params.Limit).WithOffset(params.Offset)
+}
+
+// SpacesPager returns a Pager over all spaces available to the user.
+//
+// Unlike GetSpaces, the pager doesn't fetch the members of each space.
+func (c *Client) SpacesPager() *Pager[Space] {
+	return NewPager(c.getSpacesPage)
+}
+
+// MembersPager returns a Pager over all members of a space
+func (c *Client) MembersPager(spaceID string) *Pager[Member] {
+	return NewPager(func(ctx context.Context, offset, limit int) ([]Member, Pagination, error) {
+		return c.getMembersPage(ctx, spaceID, offset, limit)
+	})
+}
+
+// TypesPager returns a Pager over all types of a space
+func (c *Client) TypesPager(spaceID string) *Pager[TypeInfo] {
+	return NewPager(func(ctx context.Context, offset, limit int) ([]TypeInfo, Pagination, error) {
+		return c.getTypesPage(ctx, spaceID, offset, limit)
+	})
+}
+
+// PropertiesPager returns a Pager over all property definitions of a space
+func (c *Client) PropertiesPager(spaceID string) *Pager[PropertyDefinition] {
+	return NewPager(func(ctx context.Context, offset, limit int) ([]PropertyDefinition, Pagination, error) {
+		return c.getPropertiesPage(ctx, spaceID, offset, limit)
+	})
+}
+
+// TagsPager returns a Pager over all tags of a select or multi_select property
+func (c *Client) TagsPager(spaceID, propertyID string) *Pager[PropertyTag] {
+	return NewPager(func(ctx context.Context, offset, limit int) ([]PropertyTag, Pagination, error) {
+		return c.getTagsPage(ctx, spaceID, propertyID, offset, limit)
+	})
+}
+
+// completePagination returns pagination metadata describing a complete list of items
+func completePagination(count int) Pagination {
+	return Pagination{
+		Total:   count,
+		Offset:  0,
+		Limit:   count,
+		HasMore: false,
+	}
+}
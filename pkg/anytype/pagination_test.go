@@ -0,0 +1,196 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// pageOf returns the page of ids starting at offset, as the API would
+func pageOf(ids []string, offset, limit int) ([]map[string]string, Pagination) {
+	if offset > len(ids) {
+		offset = len(ids)
+	}
+	end := offset + limit
+	if end > len(ids) {
+		end = len(ids)
+	}
+	items := make([]map[string]string, 0, end-offset)
+	for _, id := range ids[offset:end] {
+		items = append(items, map[string]string{"id": id, "name": "Name " + id})
+	}
+	return items, Pagination{Total: len(ids), Offset: offset, Limit: limit, HasMore: end < len(ids)}
+}
+
+// setupPagedServer serves count items on every list endpoint honoring offset and limit
+func setupPagedServer(t *testing.T, count int) (*httptest.Server, *Client, *[]string) {
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("item%d", i)
+	}
+
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, limit := 0, 100
+		if r.Method == http.MethodPost {
+			var body SearchRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode search body: %v", err)
+			}
+			offset, limit = body.Offset, body.Limit
+		} else {
+			if v := r.URL.Query().Get("offset"); v != "" {
+				offset, _ = strconv.Atoi(v)
+			}
+			if v := r.URL.Query().Get("limit"); v != "" {
+				limit, _ = strconv.Atoi(v)
+			}
+		}
+
+		mu.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s %d/%d", r.Method, r.URL.Path, offset, limit))
+		mu.Unlock()
+
+		items, pagination := pageOf(ids, offset, limit)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": items, "pagination": pagination})
+	}))
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return server, client, &requests
+}
+
+// TestPagerNext tests walking pages one by one
+func TestPagerNext(t *testing.T) {
+	server, client, requests := setupPagedServer(t, 25)
+	defer server.Close()
+
+	pager := client.SearchPager("space123", &SearchParams{Limit: 10})
+	var pages, total int
+	for pager.HasNext() {
+		objects, err := pager.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		pages++
+		total += len(objects)
+	}
+
+	if pages != 3 || total != 25 {
+		t.Fatalf("Expected 25 objects in 3 pages, got %d in %d pages", total, pages)
+	}
+	if len(*requests) != 3 {
+		t.Fatalf("Expected 3 requests, got %v", *requests)
+	}
+	if pager.Pagination().HasMore {
+		t.Error("Expected the last page to report no more items")
+	}
+	if objects, err := pager.Next(context.Background()); err != nil || len(objects) != 0 {
+		t.Errorf("Expected an empty page after the last one, got %v, %v", objects, err)
+	}
+}
+
+// TestPagerMaxItems tests that the pager stops once the cap is reached
+func TestPagerMaxItems(t *testing.T) {
+	server, client, requests := setupPagedServer(t, 50)
+	defer server.Close()
+
+	objects, err := client.SearchPager("space123", &SearchParams{Limit: 10, Offset: 5}).
+		WithMaxItems(15).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("All failed: %v", err)
+	}
+
+	if len(objects) != 15 {
+		t.Fatalf("Expected 15 objects, got %d", len(objects))
+	}
+	if objects[0].ID != "item5" || objects[14].ID != "item19" {
+		t.Errorf("Unexpected objects: first %s, last %s", objects[0].ID, objects[14].ID)
+	}
+
+	// The second page should only request the remaining items
+	expected := []string{"POST /v1/spaces/space123/search 5/10", "POST /v1/spaces/space123/search 15/5"}
+	if fmt.Sprint(*requests) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests %v, got %v", expected, *requests)
+	}
+}
+
+// TestPagerForEach tests item iteration and early termination
+func TestPagerForEach(t *testing.T) {
+	server, client, _ := setupPagedServer(t, 30)
+	defer server.Close()
+
+	var seen int
+	err := client.TypesPager("space123").WithPageSize(7).ForEach(context.Background(), func(item TypeInfo) error {
+		seen++
+		return nil
+	})
+	if err != nil || seen != 30 {
+		t.Fatalf("Expected 30 types without error, got %d, %v", seen, err)
+	}
+
+	stop := errors.New("stop")
+	seen = 0
+	err = client.TypesPager("space123").WithPageSize(7).ForEach(context.Background(), func(item TypeInfo) error {
+		seen++
+		if seen == 10 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || seen != 10 {
+		t.Fatalf("Expected iteration to stop after 10 items, got %d, %v", seen, err)
+	}
+}
+
+// TestListEndpointsWalkAllPages tests that list methods return all pages
+func TestListEndpointsWalkAllPages(t *testing.T) {
+	server, client, _ := setupPagedServer(t, 250)
+	defer server.Close()
+	ctx := context.Background()
+
+	spaces, err := client.GetSpaces(ctx)
+	if err != nil {
+		t.Fatalf("GetSpaces failed: %v", err)
+	}
+	if len(spaces.Data) != 250 || spaces.Pagination.Total != 250 || spaces.Pagination.HasMore {
+		t.Fatalf("Expected all 250 spaces, got %d (%+v)", len(spaces.Data), spaces.Pagination)
+	}
+	if len(spaces.Data[0].Members) != 250 {
+		t.Fatalf("Expected all 250 members, got %d", len(spaces.Data[0].Members))
+	}
+
+	types, err := client.GetTypes(ctx, &GetTypesParams{SpaceID: "space123"})
+	if err != nil {
+		t.Fatalf("GetTypes failed: %v", err)
+	}
+	if len(types.Data) != 250 {
+		t.Fatalf("Expected all 250 types, got %d", len(types.Data))
+	}
+
+	page, err := client.GetTypes(ctx, &GetTypesParams{SpaceID: "space123", Offset: 240, Limit: 20})
+	if err != nil {
+		t.Fatalf("GetTypes page failed: %v", err)
+	}
+	if len(page.Data) != 10 || page.Pagination.HasMore {
+		t.Fatalf("Expected the last 10 types, got %d (%+v)", len(page.Data), page.Pagination)
+	}
+
+	objects, err := client.NewQueryBuilder("space123").WithLimit(100).WithMaxItems(120).ExecuteAll(ctx)
+	if err != nil {
+		t.Fatalf("ExecuteAll failed: %v", err)
+	}
+	if len(objects) != 120 {
+		t.Fatalf("Expected 120 objects, got %d", len(objects))
+	}
+}
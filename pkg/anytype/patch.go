@@ -0,0 +1,242 @@
+package anytype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PatchObjectParams lists the changes made to an object by PatchObject.
+//
+// Only the changes set are sent, so the rest of the object is left untouched.
+// Properties are usually built with the typed setters of an Object, but any
+// property with a format can be given: its value is always sent, so zero
+// values and cleared values reach the API.
+type PatchObjectParams struct {
+	SpaceID    string     // Space ID the object belongs to
+	ObjectID   string     // Object ID to patch
+	Name       *string    // New name of the object
+	Icon       *Icon      // New icon of the object
+	ClearIcon  bool       // Whether to remove the icon of the object
+	Properties []Property // Properties to set, each with a key and a format
+	AddTags    []string   // Names of tags to add to the object
+	RemoveTags []string   // Names or IDs of tags to remove from the object
+}
+
+// Validate validates PatchObjectParams fields
+func (p *PatchObjectParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.ObjectID == "" {
+		return ErrInvalidObjectID
+	}
+	if p.Name == nil && p.Icon == nil && !p.ClearIcon && len(p.Properties) == 0 && !p.changesTags() {
+		return fmt.Errorf("nothing to patch: %w", ErrMissingRequired)
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		return fmt.Errorf("object name cannot be empty: %w", ErrInvalidParameter)
+	}
+	if p.Icon != nil && p.ClearIcon {
+		return fmt.Errorf("icon cannot be both set and cleared: %w", ErrInvalidParameter)
+	}
+
+	keys := make(map[string]bool)
+	for _, prop := range p.Properties {
+		if prop.Key == "" {
+			return fmt.Errorf("properties need a key: %w", ErrInvalidParameter)
+		}
+		if !PropertyFormat(prop.Format).IsValid() {
+			return fmt.Errorf("property %s has an invalid format %q: %w", prop.Key, prop.Format, ErrInvalidParameter)
+		}
+		if keys[prop.Key] {
+			return fmt.Errorf("property %s is set twice: %w", prop.Key, ErrInvalidParameter)
+		}
+		keys[prop.Key] = true
+	}
+	if keys[tagPropertyKey] && p.changesTags() {
+		return fmt.Errorf("tags cannot be both set as a property and added or removed: %w", ErrInvalidParameter)
+	}
+
+	removed := make(map[string]bool)
+	for _, name := range p.RemoveTags {
+		removed[strings.ToLower(name)] = true
+	}
+	for _, names := range [][]string{p.AddTags, p.RemoveTags} {
+		for _, name := range names {
+			if strings.TrimSpace(name) == "" {
+				return fmt.Errorf("tag names cannot be empty: %w", ErrInvalidParameter)
+			}
+		}
+	}
+	for _, name := range p.AddTags {
+		if removed[strings.ToLower(name)] {
+			return fmt.Errorf("tag %s cannot be both added and removed: %w", name, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// changesTags reports whether tags are added or removed
+func (p *PatchObjectParams) changesTags() bool {
+	return len(p.AddTags) > 0 || len(p.RemoveTags) > 0
+}
+
+// patchObjectBody is the request body of PatchObject
+type patchObjectBody struct {
+	Name       *string         `json:"name,omitempty"`
+	Icon       json.RawMessage `json:"icon,omitempty"` // Icon, or null to remove it
+	Properties []Property      `json:"properties,omitempty"`
+}
+
+// PatchObject updates only the given fields and properties of an object.
+//
+// Unlike UpdateObject, fields that are not part of the changes are not sent,
+// and zero values are sent explicitly: an unchecked checkbox, a zero number
+// or a property cleared with Object.ClearProperty. The icon is removed when
+// ClearIcon is set.
+//
+// Adding or removing tags reads the current tags of the object and sends the
+// resulting tags by ID. Added tags must exist, unless the client was created
+// with WithTagResolution(true), in which case missing tags are created.
+//
+// Example:
+//
+//	changes := &anytype.Object{}
+//	changes.SetCheckbox("done", false)
+//	changes.ClearProperty("due_date", anytype.PropertyFormatDate)
+//
+//	updated, err := client.PatchObject(ctx, &anytype.PatchObjectParams{
+//	    SpaceID:    "space123",
+//	    ObjectID:   "obj456",
+//	    ClearIcon:  true,
+//	    Properties: changes.Properties,
+//	    AddTags:    []string{"urgent"},
+//	    RemoveTags: []string{"later"},
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to patch object: %v", err)
+//	}
+func (c *Client) PatchObject(ctx context.Context, params *PatchObjectParams) (*Object, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/objects/{id}", 0, "invalid patch parameters", err)
+	}
+
+	// Send the value of every property even when empty, without changing the caller's properties
+	body := patchObjectBody{Name: params.Name, Properties: make([]Property, len(params.Properties))}
+	for i, prop := range params.Properties {
+		prop.explicit = true
+		body.Properties[i] = prop
+	}
+	switch {
+	case params.ClearIcon:
+		body.Icon = json.RawMessage("null")
+	case params.Icon != nil:
+		icon, err := json.Marshal(params.Icon)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal icon: %w", err)
+		}
+		body.Icon = icon
+	}
+
+	if params.changesTags() {
+		tags, err := c.patchedTags(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		body.Properties = append(body.Properties, tags)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/objects/%s", params.SpaceID, params.ObjectID)
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object changes: %w", err)
+	}
+
+	ctx = withOperation(ctx, "PatchObject", "/v1/spaces/{space_id}/objects/{object_id}")
+	data, err = c.makeRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch object %s: %w", params.ObjectID, err)
+	}
+
+	var objectResponse struct {
+		Object Object `json:"object"`
+	}
+	if err := json.Unmarshal(data, &objectResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse patched object response: %w", err)
+	}
+
+	extractTags(&objectResponse.Object)
+
+	return &objectResponse.Object, nil
+}
+
+// patchedTags returns the tag property of an object with the tags of a patch
+// added and removed
+func (c *Client) patchedTags(ctx context.Context, params *PatchObjectParams) (Property, error) {
+	obj, err := c.GetObject(ctx, &GetObjectParams{SpaceID: params.SpaceID, ObjectID: params.ObjectID})
+	if err != nil {
+		return Property{}, err
+	}
+
+	var current []PropertyTag
+	for _, prop := range obj.Properties {
+		if prop.Key == tagPropertyKey || isTagProperty(prop) {
+			current = prop.MultiSelect
+			break
+		}
+	}
+
+	removed := func(tag PropertyTag) bool {
+		for _, name := range params.RemoveTags {
+			if tag.ID == name || strings.EqualFold(tag.Name, name) {
+				return true
+			}
+		}
+		return false
+	}
+	tags := make([]PropertyTag, 0, len(current)+len(params.AddTags))
+	for _, tag := range current {
+		if !removed(tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(params.AddTags) > 0 {
+		propertyID, err := c.tagPropertyID(ctx, params.SpaceID)
+		if err != nil {
+			return Property{}, err
+		}
+		added, err := c.ResolveTags(ctx, params.SpaceID, propertyID, params.AddTags, c.createMissingTags)
+		if err != nil {
+			return Property{}, fmt.Errorf("failed to resolve tags: %w", err)
+		}
+		for _, tag := range added {
+			if tag.ID == "" {
+				return Property{}, fmt.Errorf("tag %s: %w", tag.Name, ErrNotFound)
+			}
+			if _, found := findTagByID(tags, tag.ID); !found {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	// Sent even when empty, so that removing the last tag clears the property
+	return Property{Key: tagPropertyKey, Format: string(PropertyFormatMultiSelect), MultiSelect: tags, explicit: true}, nil
+}
+
+// findTagByID returns the tag with the given ID
+func findTagByID(tags []PropertyTag, id string) (PropertyTag, bool) {
+	for _, tag := range tags {
+		if tag.ID == id {
+			return tag, true
+		}
+	}
+	return PropertyTag{}, false
+}
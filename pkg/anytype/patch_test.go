@@ -0,0 +1,166 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestPatchObject tests that only the changes are sent, with explicit zero values and nulls
+func TestPatchObject(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"object": {"id": "obj456", "name": "Renamed"}}`, &requests)
+	defer server.Close()
+
+	changes := &Object{}
+	changes.SetCheckbox("done", false)
+	changes.ClearProperty("due_date", PropertyFormatDate)
+
+	name := "Renamed"
+	updated, err := client.PatchObject(context.Background(), &PatchObjectParams{
+		SpaceID:    "space123",
+		ObjectID:   "obj456",
+		Name:       &name,
+		ClearIcon:  true,
+		Properties: changes.Properties,
+	})
+	if err != nil {
+		t.Fatalf("PatchObject failed: %v", err)
+	}
+	if updated.Name != "Renamed" {
+		t.Errorf("Unexpected object: %+v", updated)
+	}
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPatch || requests[0].Path != "/v1/spaces/space123/objects/obj456" {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	body := requests[0].Body
+	if len(body) != 3 || body["name"] != "Renamed" {
+		t.Errorf("Expected only name, icon and properties to be sent, got %v", body)
+	}
+	if icon, ok := body["icon"]; !ok || icon != nil {
+		t.Errorf("Expected the icon to be sent as null, got %v", body)
+	}
+	properties, _ := body["properties"].([]interface{})
+	if len(properties) != 2 {
+		t.Fatalf("Expected 2 properties, got %v", body["properties"])
+	}
+	done := properties[0].(map[string]interface{})
+	due := properties[1].(map[string]interface{})
+	if checkbox, ok := done["checkbox"]; !ok || checkbox != false {
+		t.Errorf("Expected done to be sent as false, got %v", done)
+	}
+	if date, ok := due["date"]; !ok || date != nil {
+		t.Errorf("Expected due_date to be sent as null, got %v", due)
+	}
+
+	// Properties built without the setters send their zero values too
+	literal := []Property{{Key: "done", Format: "checkbox", Checkbox: false}, {Key: "estimate", Format: "number"}}
+	if _, err := client.PatchObject(context.Background(), &PatchObjectParams{SpaceID: "space123", ObjectID: "obj456", Properties: literal}); err != nil {
+		t.Fatalf("PatchObject failed: %v", err)
+	}
+	properties, _ = requests[1].Body["properties"].([]interface{})
+	if len(properties) != 2 {
+		t.Fatalf("Expected 2 properties, got %v", requests[1].Body["properties"])
+	}
+	if checkbox, ok := properties[0].(map[string]interface{})["checkbox"]; !ok || checkbox != false {
+		t.Errorf("Expected done to be sent as false, got %v", properties[0])
+	}
+	if number, ok := properties[1].(map[string]interface{})["number"]; !ok || number != float64(0) {
+		t.Errorf("Expected estimate to be sent as 0, got %v", properties[1])
+	}
+	if literal[0].explicit {
+		t.Errorf("Expected the properties of the caller to be left unchanged")
+	}
+
+	empty := ""
+	invalid := []*PatchObjectParams{
+		nil,
+		{ObjectID: "obj456", Name: &name},
+		{SpaceID: "space123", Name: &name},
+		{SpaceID: "space123", ObjectID: "obj456"},
+		{SpaceID: "space123", ObjectID: "obj456", Name: &empty},
+		{SpaceID: "space123", ObjectID: "obj456", Icon: &Icon{Emoji: "📌"}, ClearIcon: true},
+		{SpaceID: "space123", ObjectID: "obj456", Properties: []Property{{Key: "done", Checkbox: true}}},
+		{SpaceID: "space123", ObjectID: "obj456", AddTags: []string{"urgent"}, RemoveTags: []string{"Urgent"}},
+		{SpaceID: "space123", ObjectID: "obj456", AddTags: []string{" "}},
+	}
+	for _, params := range invalid {
+		if _, err := client.PatchObject(context.Background(), params); err == nil {
+			t.Errorf("Expected an error for %+v", params)
+		}
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected invalid patches not to be sent, got %d requests", len(requests))
+	}
+}
+
+// TestPatchObjectTags tests adding and removing tags by name
+func TestPatchObjectTags(t *testing.T) {
+	var patches []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/objects/obj456"):
+			w.Write([]byte(`{"object": {"id": "obj456", "properties": [{"key": "tag", "name": "Tag", "format": "multi_select",
+				"multi_select": [{"id": "tag-work", "name": "work"}, {"id": "tag-later", "name": "later"}]}]}}`))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/properties"):
+			w.Write([]byte(`{"data": [{"id": "prop-tag", "key": "tag", "name": "Tag", "format": "multi_select"}], "pagination": {"total": 1}}`))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/tags"):
+			w.Write([]byte(`{"data": [{"id": "tag-work", "name": "work"}, {"id": "tag-urgent", "name": "Urgent"}], "pagination": {"total": 2}}`))
+		case r.Method == http.MethodPatch:
+			request := recordedRequest{Method: r.Method, Path: r.URL.Path}
+			json.NewDecoder(r.Body).Decode(&request.Body)
+			patches = append(patches, request)
+			w.Write([]byte(`{"object": {"id": "obj456"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.PatchObject(context.Background(), &PatchObjectParams{
+		SpaceID:    "space123",
+		ObjectID:   "obj456",
+		AddTags:    []string{"urgent", "work"},
+		RemoveTags: []string{"later"},
+	})
+	if err != nil {
+		t.Fatalf("PatchObject failed: %v", err)
+	}
+
+	if len(patches) != 1 {
+		t.Fatalf("Expected a single patch, got %+v", patches)
+	}
+	properties, _ := patches[0].Body["properties"].([]interface{})
+	if len(patches[0].Body) != 1 || len(properties) != 1 {
+		t.Fatalf("Expected only the tag property to be sent, got %v", patches[0].Body)
+	}
+	tags, _ := properties[0].(map[string]interface{})["multi_select"].([]interface{})
+	ids := []string{}
+	for _, tag := range tags {
+		ids = append(ids, tag.(map[string]interface{})["id"].(string))
+	}
+	if strings.Join(ids, ",") != "tag-work,tag-urgent" {
+		t.Errorf("Expected work and urgent tags, got %v", tags)
+	}
+
+	// Missing tags are not created without tag resolution
+	_, err = client.PatchObject(context.Background(), &PatchObjectParams{SpaceID: "space123", ObjectID: "obj456", AddTags: []string{"customer"}})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing tag, got %v", err)
+	}
+	if len(patches) != 1 {
+		t.Errorf("Expected the patch with a missing tag not to be sent")
+	}
+}
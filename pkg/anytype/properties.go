@@ -0,0 +1,384 @@
+package anytype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PropertyFormat is the format of the values of a property
+type PropertyFormat string
+
+// Property formats
+const (
+	PropertyFormatText        PropertyFormat = "text"
+	PropertyFormatNumber      PropertyFormat = "number"
+	PropertyFormatSelect      PropertyFormat = "select"
+	PropertyFormatMultiSelect PropertyFormat = "multi_select"
+	PropertyFormatDate        PropertyFormat = "date"
+	PropertyFormatFiles       PropertyFormat = "files"
+	PropertyFormatCheckbox    PropertyFormat = "checkbox"
+	PropertyFormatURL         PropertyFormat = "url"
+	PropertyFormatEmail       PropertyFormat = "email"
+	PropertyFormatPhone       PropertyFormat = "phone"
+	PropertyFormatObjects     PropertyFormat = "objects"
+)
+
+// IsValid reports whether the format is a known format
+func (f PropertyFormat) IsValid() bool {
+	switch f {
+	case PropertyFormatText, PropertyFormatNumber, PropertyFormatSelect, PropertyFormatMultiSelect,
+		PropertyFormatDate, PropertyFormatFiles, PropertyFormatCheckbox, PropertyFormatURL,
+		PropertyFormatEmail, PropertyFormatPhone, PropertyFormatObjects:
+		return true
+	}
+	return false
+}
+
+// HasTags reports whether values of the format are tags
+func (f PropertyFormat) HasTags() bool {
+	return f == PropertyFormatSelect || f == PropertyFormatMultiSelect
+}
+
+// PropertiesResponse represents the property definitions of a space
+type PropertiesResponse struct {
+	Data       []PropertyDefinition `json:"data"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+// CreatePropertyParams represents parameters for creating a property in a space
+type CreatePropertyParams struct {
+	SpaceID string         `json:"-"`              // Space ID to create the property in
+	Key     string         `json:"key,omitempty"`  // Key of the property, generated from the name if empty
+	Name    string         `json:"name"`           // Display name of the property
+	Format  PropertyFormat `json:"format"`         // Format of the property values
+	Tags    []PropertyTag  `json:"tags,omitempty"` // Initial tags of a select or multi_select property
+}
+
+// Validate validates CreatePropertyParams fields
+func (p *CreatePropertyParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("property name is required: %w", ErrMissingRequired)
+	}
+	if !p.Format.IsValid() {
+		return fmt.Errorf("invalid property format %q: %w", p.Format, ErrInvalidParameter)
+	}
+	if len(p.Tags) > 0 && !p.Format.HasTags() {
+		return fmt.Errorf("only select and multi_select properties have tags: %w", ErrInvalidParameter)
+	}
+	return nil
+}
+
+// UpdatePropertyParams represents parameters for updating a property.
+// Only the fields that are set are updated; the format of a property can't be changed.
+type UpdatePropertyParams struct {
+	SpaceID    string  `json:"-"`              // Space ID the property belongs to
+	PropertyID string  `json:"-"`              // Property ID to update
+	Key        *string `json:"key,omitempty"`  // New key of the property
+	Name       *string `json:"name,omitempty"` // New display name of the property
+}
+
+// Validate validates UpdatePropertyParams fields
+func (p *UpdatePropertyParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.PropertyID == "" {
+		return fmt.Errorf("property ID is required: %w", ErrMissingRequired)
+	}
+	if p.Key == nil && p.Name == nil {
+		return fmt.Errorf("nothing to update: %w", ErrMissingRequired)
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		return fmt.Errorf("property name cannot be empty: %w", ErrInvalidParameter)
+	}
+	return nil
+}
+
+// GetProperties retrieves all property definitions of a space, walking through all result pages
+func (c *Client) GetProperties(ctx context.Context, spaceID string) (*PropertiesResponse, error) {
+	if spaceID == "" {
+		return nil, ErrInvalidSpaceID
+	}
+
+	properties, err := c.PropertiesPager(spaceID).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PropertiesResponse{Data: properties, Pagination: completePagination(len(properties))}, nil
+}
+
+// getPropertiesPage retrieves a single page of property definitions of a space
+func (c *Client) getPropertiesPage(ctx context.Context, spaceID string, offset, limit int) ([]PropertyDefinition, Pagination, error) {
+	path := fmt.Sprintf("/v1/spaces/%s/properties?offset=%d&limit=%d", spaceID, offset, limit)
+	ctx = withOperation(ctx, "GetProperties", "/v1/spaces/{space_id}/properties")
+	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, Pagination{}, wrapError(path, 0, fmt.Sprintf("failed to get properties for space %s", spaceID), err)
+	}
+
+	var response PropertiesResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, Pagination{}, wrapError(path, 0, "failed to parse properties response", err)
+	}
+
+	return response.Data, response.Pagination, nil
+}
+
+// GetProperty retrieves a property definition by ID
+func (c *Client) GetProperty(ctx context.Context, spaceID, propertyID string) (*PropertyDefinition, error) {
+	if spaceID == "" {
+		return nil, ErrInvalidSpaceID
+	}
+	if propertyID == "" {
+		return nil, wrapError("/v1/spaces/{id}/properties/{property_id}", 0, "property ID is required", ErrMissingRequired)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s", spaceID, propertyID)
+	ctx = withOperation(ctx, "GetProperty", "/v1/spaces/{space_id}/properties/{property_id}")
+	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to get property %s", propertyID), err)
+	}
+
+	return parsePropertyResponse(path, data)
+}
+
+// CreateProperty creates a property in a space.
+//
+// Example:
+//
+//	severity, err := client.CreateProperty(ctx, &anytype.CreatePropertyParams{
+//	    SpaceID: "space123",
+//	    Key:     "severity",
+//	    Name:    "Severity",
+//	    Format:  anytype.PropertyFormatSelect,
+//	    Tags: []anytype.PropertyTag{
+//	        {Name: "SEV1", Color: "red"},
+//	        {Name: "SEV2", Color: "orange"},
+//	    },
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to create property: %v", err)
+//	}
+func (c *Client) CreateProperty(ctx context.Context, params *CreatePropertyParams) (*PropertyDefinition, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/properties", 0, "invalid property parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties", params.SpaceID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal property", err)
+	}
+
+	ctx = withOperation(ctx, "CreateProperty", "/v1/spaces/{space_id}/properties")
+	data, err := c.makeRequest(ctx, http.MethodPost, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to create property %s", params.Name), err)
+	}
+
+	return parsePropertyResponse(path, data)
+}
+
+// UpdateProperty renames a property or changes its key.
+//
+// Example:
+//
+//	name := "Impact"
+//	updated, err := client.UpdateProperty(ctx, &anytype.UpdatePropertyParams{
+//	    SpaceID:    "space123",
+//	    PropertyID: "prop456",
+//	    Name:       &name,
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to update property: %v", err)
+//	}
+func (c *Client) UpdateProperty(ctx context.Context, params *UpdatePropertyParams) (*PropertyDefinition, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/properties/{property_id}", 0, "invalid property parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s", params.SpaceID, params.PropertyID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal property", err)
+	}
+
+	ctx = withOperation(ctx, "UpdateProperty", "/v1/spaces/{space_id}/properties/{property_id}")
+	data, err := c.makeRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to update property %s", params.PropertyID), err)
+	}
+
+	return parsePropertyResponse(path, data)
+}
+
+// DeleteProperty archives a property.
+//
+// Example:
+//
+//	if err := client.DeleteProperty(ctx, "space123", "prop456"); err != nil {
+//	    log.Fatalf("Failed to delete property: %v", err)
+//	}
+func (c *Client) DeleteProperty(ctx context.Context, spaceID, propertyID string) error {
+	if spaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if propertyID == "" {
+		return wrapError("/v1/spaces/{id}/properties/{property_id}", 0, "property ID is required", ErrMissingRequired)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s", spaceID, propertyID)
+	ctx = withOperation(ctx, "DeleteProperty", "/v1/spaces/{space_id}/properties/{property_id}")
+	if _, err := c.makeRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return wrapError(path, 0, fmt.Sprintf("failed to delete property %s", propertyID), err)
+	}
+
+	return nil
+}
+
+// parsePropertyResponse parses a response wrapping a single property definition
+func parsePropertyResponse(path string, data []byte) (*PropertyDefinition, error) {
+	var response struct {
+		Property PropertyDefinition `json:"property"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, wrapError(path, 0, "failed to parse property response", err)
+	}
+
+	return &response.Property, nil
+}
+
+// ValidateValue checks that a property value can be written to a property
+// with this definition.
+//
+// The value must only use the field matching the format of the definition,
+// and dates, URLs and email addresses must be well-formed.
+func (d PropertyDefinition) ValidateValue(p Property) error {
+	if p.Format != "" && p.Format != string(d.Format) {
+		return fmt.Errorf("property %s has format %s, not %s: %w", d.Key, d.Format, p.Format, ErrInvalidParameter)
+	}
+
+	for _, format := range valueFormats(p) {
+		if format != d.Format {
+			return fmt.Errorf("property %s has format %s but a %s value was given: %w", d.Key, d.Format, format, ErrInvalidParameter)
+		}
+	}
+
+	switch d.Format {
+	case PropertyFormatDate:
+		if _, ok := parseFilterDate(p.Date); p.Date != "" && !ok {
+			return fmt.Errorf("property %s has an invalid date %q: %w", d.Key, p.Date, ErrInvalidParameter)
+		}
+	case PropertyFormatURL:
+		if p.URL != "" {
+			if u, err := url.Parse(p.URL); err != nil || u.Scheme == "" {
+				return fmt.Errorf("property %s has an invalid URL %q: %w", d.Key, p.URL, ErrInvalidParameter)
+			}
+		}
+	case PropertyFormatEmail:
+		if p.Email != "" && !strings.Contains(p.Email, "@") {
+			return fmt.Errorf("property %s has an invalid email %q: %w", d.Key, p.Email, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// ValidateProperties checks property values against the property definitions
+// of a space or a type, matching them by key. Properties without a key are
+// matched by name, ignoring case.
+//
+// Example:
+//
+//	typeInfo, err := client.GetType(ctx, "space123", "type456")
+//	if err != nil {
+//	    log.Fatalf("Failed to get type: %v", err)
+//	}
+//
+//	if err := anytype.ValidateProperties(typeInfo.Properties, object.Properties); err != nil {
+//	    log.Fatalf("Invalid object: %v", err)
+//	}
+func ValidateProperties(definitions []PropertyDefinition, properties []Property) error {
+	for _, property := range properties {
+		definition, ok := findPropertyDefinition(definitions, property)
+		if !ok {
+			name := property.Key
+			if name == "" {
+				name = property.Name
+			}
+			return fmt.Errorf("unknown property %q: %w", name, ErrInvalidParameter)
+		}
+		if err := definition.ValidateValue(property); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findPropertyDefinition returns the definition of a property, matched by key or,
+// for properties without a key, by name ignoring case
+func findPropertyDefinition(definitions []PropertyDefinition, property Property) (PropertyDefinition, bool) {
+	for _, definition := range definitions {
+		if property.Key != "" && definition.Key == property.Key {
+			return definition, true
+		}
+		if property.Key == "" && property.Name != "" && strings.EqualFold(definition.Name, property.Name) {
+			return definition, true
+		}
+	}
+	return PropertyDefinition{}, false
+}
+
+// valueFormats returns the formats of the value fields set on a property
+func valueFormats(p Property) []PropertyFormat {
+	var formats []PropertyFormat
+	if p.Text != "" {
+		formats = append(formats, PropertyFormatText)
+	}
+	if p.Number != 0 {
+		formats = append(formats, PropertyFormatNumber)
+	}
+	if p.Select != nil {
+		formats = append(formats, PropertyFormatSelect)
+	}
+	if len(p.MultiSelect) > 0 {
+		formats = append(formats, PropertyFormatMultiSelect)
+	}
+	if p.Date != "" {
+		formats = append(formats, PropertyFormatDate)
+	}
+	if len(p.File) > 0 {
+		formats = append(formats, PropertyFormatFiles)
+	}
+	if p.Checkbox {
+		formats = append(formats, PropertyFormatCheckbox)
+	}
+	if p.URL != "" {
+		formats = append(formats, PropertyFormatURL)
+	}
+	if p.Email != "" {
+		formats = append(formats, PropertyFormatEmail)
+	}
+	if p.Phone != "" {
+		formats = append(formats, PropertyFormatPhone)
+	}
+	if len(p.Object) > 0 {
+		formats = append(formats, PropertyFormatObjects)
+	}
+	return formats
+}
@@ -0,0 +1,140 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// TestPropertyManagement tests creating, getting, updating and deleting properties
+func TestPropertyManagement(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"property": {"object": "property", "id": "prop1", "key": "severity", "name": "Severity", "format": "select"}}`, &requests)
+	defer server.Close()
+	ctx := context.Background()
+
+	property, err := client.CreateProperty(ctx, &CreatePropertyParams{
+		SpaceID: "space123",
+		Key:     "severity",
+		Name:    "Severity",
+		Format:  PropertyFormatSelect,
+		Tags:    []PropertyTag{{Name: "SEV1", Color: "red"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateProperty failed: %v", err)
+	}
+	if property.ID != "prop1" || property.Format != PropertyFormatSelect {
+		t.Errorf("Unexpected property: %+v", property)
+	}
+	tags, _ := requests[0].Body["tags"].([]interface{})
+	if requests[0].Method != http.MethodPost || requests[0].Path != "/v1/spaces/space123/properties" ||
+		requests[0].Body["format"] != "select" || len(tags) != 1 {
+		t.Errorf("Unexpected request: %+v", requests[0])
+	}
+
+	if _, err := client.GetProperty(ctx, "space123", "prop1"); err != nil {
+		t.Fatalf("GetProperty failed: %v", err)
+	}
+	name := "Impact"
+	if _, err := client.UpdateProperty(ctx, &UpdatePropertyParams{SpaceID: "space123", PropertyID: "prop1", Name: &name}); err != nil {
+		t.Fatalf("UpdateProperty failed: %v", err)
+	}
+	if err := client.DeleteProperty(ctx, "space123", "prop1"); err != nil {
+		t.Fatalf("DeleteProperty failed: %v", err)
+	}
+
+	expected := []string{"GET /v1/spaces/space123/properties/prop1", "PATCH /v1/spaces/space123/properties/prop1", "DELETE /v1/spaces/space123/properties/prop1"}
+	for i, request := range requests[1:] {
+		if request.Method+" "+request.Path != expected[i] {
+			t.Errorf("Expected %s, got %s %s", expected[i], request.Method, request.Path)
+		}
+	}
+	if len(requests[2].Body) != 1 || requests[2].Body["name"] != "Impact" {
+		t.Errorf("Expected only the name to be sent, got %v", requests[2].Body)
+	}
+
+	invalid := []*CreatePropertyParams{
+		{Name: "Severity", Format: PropertyFormatSelect},
+		{SpaceID: "space123", Format: PropertyFormatSelect},
+		{SpaceID: "space123", Name: "Severity", Format: "enum"},
+		{SpaceID: "space123", Name: "Due", Format: PropertyFormatDate, Tags: []PropertyTag{{Name: "x"}}},
+	}
+	for _, params := range invalid {
+		if _, err := client.CreateProperty(ctx, params); err == nil {
+			t.Errorf("Expected an error for %+v", params)
+		}
+	}
+	if _, err := client.UpdateProperty(ctx, &UpdatePropertyParams{SpaceID: "space123", PropertyID: "prop1"}); !errors.Is(err, ErrMissingRequired) {
+		t.Errorf("Expected ErrMissingRequired for an empty update, got %v", err)
+	}
+	if len(requests) != 4 {
+		t.Errorf("Expected invalid requests not to be sent, got %d requests", len(requests))
+	}
+}
+
+// TestGetProperties tests listing the property definitions of a space
+func TestGetProperties(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{
+		"data": [{"id": "prop1", "key": "severity", "format": "select"}, {"id": "prop2", "key": "due_date", "format": "date"}],
+		"pagination": {"total": 2, "has_more": false}
+	}`, &requests)
+	defer server.Close()
+
+	properties, err := client.GetProperties(context.Background(), "space123")
+	if err != nil {
+		t.Fatalf("GetProperties failed: %v", err)
+	}
+	if len(properties.Data) != 2 || properties.Data[1].Format != PropertyFormatDate {
+		t.Errorf("Unexpected properties: %+v", properties.Data)
+	}
+	if len(requests) != 1 || requests[0].Path != "/v1/spaces/space123/properties" {
+		t.Errorf("Unexpected requests: %+v", requests)
+	}
+}
+
+// TestValidateProperties tests checking property values against their definitions
+func TestValidateProperties(t *testing.T) {
+	definitions := []PropertyDefinition{
+		{Key: "severity", Format: PropertyFormatSelect},
+		{Key: "due_date", Name: "Due date", Format: PropertyFormatDate},
+		{Key: "done", Format: PropertyFormatCheckbox},
+		{Key: "link", Format: PropertyFormatURL},
+		{Key: "contact", Format: PropertyFormatEmail},
+	}
+
+	valid := []Property{
+		{Key: "severity", Select: &PropertyTag{Name: "SEV1"}},
+		{Key: "due_date", Date: "2025-06-01"},
+		{Key: "due_date", Format: "date", Date: "2025-06-01T10:00:00Z"},
+		{Name: "due DATE", Date: "2025-06-01"},
+		{Key: "done", Checkbox: true},
+		{Key: "done"},
+		{Key: "link", URL: "https://example.com"},
+		{Key: "contact", Email: "oncall@example.com"},
+	}
+	for _, property := range valid {
+		if err := ValidateProperties(definitions, []Property{property}); err != nil {
+			t.Errorf("Expected %+v to be valid, got %v", property, err)
+		}
+	}
+
+	invalid := []Property{
+		{Key: "unknown", Text: "x"},
+		{Key: "severity", Text: "SEV1"},
+		{Key: "severity", Format: "multi_select"},
+		{Key: "due_date", Date: "tomorrow"},
+		{Name: "Due date", Date: "tomorrow"},
+		{Name: "Unknown", Text: "x"},
+		{Key: "unknown", Name: "Due date", Date: "2025-06-01"},
+		{Key: "done", Number: 1},
+		{Key: "link", URL: "example.com"},
+		{Key: "contact", Email: "oncall"},
+	}
+	for _, property := range invalid {
+		if err := ValidateProperties(definitions, []Property{property}); !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("Expected ErrInvalidParameter for %+v, got %v", property, err)
+		}
+	}
+}
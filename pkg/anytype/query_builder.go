@@ -9,11 +9,15 @@ import (
 
 // QueryBuilder provides a fluent interface for building and executing search queries
 type QueryBuilder struct {
-	client  *Client
-	spaceID string
-	params  *SearchParams
-	timeout time.Duration
-	err     error
+	client           *Client
+	spaceID          string
+	params           *SearchParams
+	timeout          time.Duration
+	maxItems         int
+	hydrationWorkers int
+	typeNames        []string // Type names resolved by WithType, kept for Definition
+	typeKeys         []string // Type keys added by WithTypeKeys, kept for Definition
+	err              error
 }
 
 // NewQueryBuilder creates a new query builder for the given space
@@ -52,6 +56,7 @@ func (qb *QueryBuilder) WithType(ctx context.Context, typeName string) *QueryBui
 	}
 
 	qb.params.Types = append(qb.params.Types, typeKey)
+	qb.typeNames = append(qb.typeNames, typeName)
 	return qb
 }
 
@@ -84,6 +89,7 @@ func (qb *QueryBuilder) WithTypeKeys(typeKeys ...string) *QueryBuilder {
 	for _, typeKey := range typeKeys {
 		if typeKey != "" {
 			qb.params.Types = append(qb.params.Types, typeKey)
+			qb.typeKeys = append(qb.typeKeys, typeKey)
 		}
 	}
 
@@ -92,34 +98,62 @@ func (qb *QueryBuilder) WithTypeKeys(typeKeys ...string) *QueryBuilder {
 
 // WithTag adds a single tag filter
 func (qb *QueryBuilder) WithTag(tag string) *QueryBuilder {
+	return qb.WithTags(tag)
+}
+
+// WithTags adds multiple tag filters.
+// Objects matching any of the tags are returned
+func (qb *QueryBuilder) WithTags(tags ...string) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
 
-	tag = strings.TrimSpace(tag)
-	if tag != "" {
-		qb.params.Tags = append(qb.params.Tags, tag)
+	qb.params.Tags = appendTrimmed(qb.params.Tags, tags)
+	return qb
+}
+
+// WithAllTags adds tags that objects must all have
+func (qb *QueryBuilder) WithAllTags(tags ...string) *QueryBuilder {
+	if qb.err != nil {
+		return qb
 	}
 
+	qb.params.AllTags = appendTrimmed(qb.params.AllTags, tags)
 	return qb
 }
 
-// WithTags adds multiple tag filters
-func (qb *QueryBuilder) WithTags(tags ...string) *QueryBuilder {
+// WithoutTags excludes objects having any of the tags
+func (qb *QueryBuilder) WithoutTags(tags ...string) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
 
-	for _, tag := range tags {
-		tag = strings.TrimSpace(tag)
-		if tag != "" {
-			qb.params.Tags = append(qb.params.Tags, tag)
-		}
+	qb.params.ExcludeTags = appendTrimmed(qb.params.ExcludeTags, tags)
+	return qb
+}
+
+// WithTagIDs adds tag filters by ID rather than by name.
+// Objects having any of the tag IDs are returned
+func (qb *QueryBuilder) WithTagIDs(tagIDs ...string) *QueryBuilder {
+	if qb.err != nil {
+		return qb
 	}
 
+	qb.params.TagIDs = appendTrimmed(qb.params.TagIDs, tagIDs)
 	return qb
 }
 
+// appendTrimmed appends the non-empty trimmed values to dst
+func appendTrimmed(dst []string, values []string) []string {
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			dst = append(dst, value)
+		}
+	}
+	return dst
+}
+
 // WithLimit sets the maximum number of results to return
 func (qb *QueryBuilder) WithLimit(limit int) *QueryBuilder {
 	if qb.err != nil {
@@ -150,44 +184,66 @@ func (qb *QueryBuilder) WithOffset(offset int) *QueryBuilder {
 	return qb
 }
 
-// WithSortField adds sorting by a specific field
-func (qb *QueryBuilder) WithSortField(field string, ascending bool) *QueryBuilder {
+// WithSort adds a sort key to the query.
+//
+// Keys apply in the order they are added: each key orders the objects that
+// are equal according to the previous ones.
+//
+// Example:
+//
+//	qb.WithSort("status", anytype.SortAsc).
+//	    WithSort(anytype.SortByLastModifiedDate, anytype.SortDesc)
+func (qb *QueryBuilder) WithSort(property SortProperty, direction SortDirection) *QueryBuilder {
 	if qb.err != nil {
 		return qb
 	}
 
-	field = strings.TrimSpace(field)
-	if field == "" {
-		qb.err = fmt.Errorf("sort field cannot be empty")
+	sort := SortOptions{
+		Property:  SortProperty(strings.TrimSpace(string(property))),
+		Direction: direction,
+	}
+	if err := sort.Validate(); err != nil {
+		qb.err = err
 		return qb
 	}
 
-	direction := "desc"
-	if ascending {
-		direction = "asc"
+	qb.params.Sorts = append(qb.params.Sorts, sort)
+	return qb
+}
+
+// WithSortField adds sorting by a specific field
+func (qb *QueryBuilder) WithSortField(field string, ascending bool) *QueryBuilder {
+	if strings.TrimSpace(field) == "" && qb.err == nil {
+		qb.err = fmt.Errorf("sort field cannot be empty")
+		return qb
 	}
 
-	qb.params.Sort = &SortOptions{
-		Property:  field,
-		Direction: direction,
+	direction := SortDesc
+	if ascending {
+		direction = SortAsc
 	}
 
-	return qb
+	return qb.WithSort(SortProperty(field), direction)
 }
 
-// WithSortByName sets sorting by object name
+// WithSortByName adds sorting by object name
 func (qb *QueryBuilder) WithSortByName(ascending bool) *QueryBuilder {
-	return qb.WithSortField("name", ascending)
+	return qb.WithSortField(string(SortByName), ascending)
 }
 
-// WithSortByCreatedAt sets sorting by creation date
+// WithSortByCreatedAt adds sorting by creation date
 func (qb *QueryBuilder) WithSortByCreatedAt(ascending bool) *QueryBuilder {
-	return qb.WithSortField("createdAt", ascending)
+	return qb.WithSortField(string(SortByCreatedDate), ascending)
 }
 
-// WithSortByUpdatedAt sets sorting by last update date
+// WithSortByUpdatedAt adds sorting by last modification date
 func (qb *QueryBuilder) WithSortByUpdatedAt(ascending bool) *QueryBuilder {
-	return qb.WithSortField("updatedAt", ascending)
+	return qb.WithSortField(string(SortByLastModifiedDate), ascending)
+}
+
+// WithSortByLastOpened adds sorting by the date the object was last opened
+func (qb *QueryBuilder) WithSortByLastOpened(ascending bool) *QueryBuilder {
+	return qb.WithSortField(string(SortByLastOpenedDate), ascending)
 }
 
 // WithTimeout sets a custom timeout for this specific search operation
@@ -206,6 +262,22 @@ func (qb *QueryBuilder) WithTimeout(timeout time.Duration) *QueryBuilder {
 	return qb
 }
 
+// WithMaxItems caps the total number of objects returned by ExecuteAll and Pager.
+// A value of 0 means no limit.
+func (qb *QueryBuilder) WithMaxItems(maxItems int) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+
+	if maxItems < 0 {
+		qb.err = fmt.Errorf("max items cannot be negative")
+		return qb
+	}
+
+	qb.maxItems = maxItems
+	return qb
+}
+
 // GetParams returns a copy of the current search parameters
 // This can be useful for saving a query for later use
 func (qb *QueryBuilder) GetParams() (*SearchParams, error) {
@@ -240,7 +312,10 @@ func (qb *QueryBuilder) Execute(ctx context.Context) (*SearchResponse, error) {
 	return qb.client.Search(ctx, qb.spaceID, qb.params)
 }
 
-// ExecuteWithCallback runs the search and processes results with a callback function
+// ExecuteWithCallback runs the search and processes results with a callback function.
+//
+// Only the objects of the requested page are processed; use Stream to
+// process all matching objects.
 func (qb *QueryBuilder) ExecuteWithCallback(ctx context.Context, callback func(obj Object) error) error {
 	if qb.err != nil {
 		return qb.err
@@ -268,3 +343,42 @@ func (qb *QueryBuilder) ExecuteWithCallback(ctx context.Context, callback func(o
 
 	return nil
 }
+
+// Pager returns a Pager over all objects matching the query.
+//
+// The limit set with WithLimit is used as the page size and the offset set
+// with WithOffset as the starting offset.
+func (qb *QueryBuilder) Pager() (*Pager[Object], error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+
+	paramsCopy := *qb.params
+	return qb.client.SearchPager(qb.spaceID, &paramsCopy).WithMaxItems(qb.maxItems), nil
+}
+
+// ExecuteAll runs the search and fetches all result pages.
+//
+// The number of returned objects can be capped with WithMaxItems.
+//
+// Example:
+//
+//	objects, err := client.NewQueryBuilder("space123").
+//	    WithQuery("meeting").
+//	    WithMaxItems(1000).
+//	    ExecuteAll(ctx)
+func (qb *QueryBuilder) ExecuteAll(ctx context.Context) ([]Object, error) {
+	pager, err := qb.Pager()
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply custom timeout if specified
+	if qb.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, qb.timeout)
+		defer cancel()
+	}
+
+	return pager.All(ctx)
+}
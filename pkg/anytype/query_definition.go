@@ -0,0 +1,179 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/epheo/anytype-go/internal/yamljson"
+	"gopkg.in/yaml.v3"
+)
+
+// QueryDefinition is a serializable description of a query.
+//
+// Unlike SearchParams, it refers to types by name, so that a definition can
+// be saved and applied to any space. Definitions are created with
+// QueryBuilder.Definition or ParsedQuery.Definition and applied with
+// QueryBuilder.FromDefinition.
+//
+// Definitions can be serialized to and from JSON and YAML, with the same
+// field names in both formats.
+//
+// Example:
+//
+//	def, err := client.NewQueryBuilder("space123").
+//	    WithTypes(ctx, "Task").
+//	    WithAllTags("urgent").
+//	    WhereDate("due_date").Before(time.Now()).
+//	    WithSort(anytype.SortByLastModifiedDate, anytype.SortDesc).
+//	    Definition()
+//	if err != nil {
+//	    log.Fatalf("Invalid query: %v", err)
+//	}
+//
+//	data, err := yaml.Marshal(def)
+type QueryDefinition struct {
+	Query       string            `json:"query,omitempty"`        // Search term
+	Types       []string          `json:"types,omitempty"`        // Type names, resolved when the definition is applied
+	TypeKeys    []string          `json:"type_keys,omitempty"`    // Type keys, used as is
+	Tags        []string          `json:"tags,omitempty"`         // Tags to filter by, matching any of them
+	AllTags     []string          `json:"all_tags,omitempty"`     // Tags that objects must all have
+	ExcludeTags []string          `json:"exclude_tags,omitempty"` // Tags that objects must not have
+	TagIDs      []string          `json:"tag_ids,omitempty"`      // Tag IDs to filter by, matching any of them
+	Filters     *FilterExpression `json:"filters,omitempty"`      // Property filters
+	Sorts       []SortOptions     `json:"sorts,omitempty"`        // Sorting options in order of precedence
+	Limit       int               `json:"limit,omitempty"`        // Result limit, or page size when streaming
+	Offset      int               `json:"offset,omitempty"`       // Result offset
+	MaxItems    int               `json:"max_items,omitempty"`    // Cap on the objects returned by ExecuteAll and Stream
+}
+
+// Validate validates QueryDefinition fields
+func (d *QueryDefinition) Validate() error {
+	if d.Limit < 0 || d.Offset < 0 || d.MaxItems < 0 {
+		return ErrInvalidParameter
+	}
+	for _, sort := range d.Sorts {
+		if err := sort.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, using the JSON field names
+func (d QueryDefinition) MarshalYAML() (interface{}, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return yamljson.JSONToNode(data)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, using the JSON field names
+func (d *QueryDefinition) UnmarshalYAML(node *yaml.Node) error {
+	data, err := yamljson.NodeToJSON(node)
+	if err != nil {
+		return fmt.Errorf("invalid query definition: %w", err)
+	}
+	return json.Unmarshal(data, d)
+}
+
+// Definition returns the serializable definition of the query.
+//
+// Types added with WithType or WithTypes are recorded by name, and types
+// added with WithTypeKeys by key.
+func (qb *QueryBuilder) Definition() (*QueryDefinition, error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+
+	def := &QueryDefinition{
+		Query:       qb.params.Query,
+		Types:       append([]string(nil), qb.typeNames...),
+		TypeKeys:    append([]string(nil), qb.typeKeys...),
+		Tags:        append([]string(nil), qb.params.Tags...),
+		AllTags:     append([]string(nil), qb.params.AllTags...),
+		ExcludeTags: append([]string(nil), qb.params.ExcludeTags...),
+		TagIDs:      append([]string(nil), qb.params.TagIDs...),
+		Sorts:       qb.params.sortKeys(),
+		Limit:       qb.params.Limit,
+		Offset:      qb.params.Offset,
+		MaxItems:    qb.maxItems,
+	}
+	if !qb.params.Filters.IsEmpty() {
+		filters := *qb.params.Filters
+		def.Filters = &filters
+	}
+	return def, nil
+}
+
+// Definition returns the query definition equivalent to the parsed query
+func (q *ParsedQuery) Definition() *QueryDefinition {
+	def := &QueryDefinition{
+		Query:       q.Text,
+		Types:       q.Types,
+		AllTags:     q.Tags,
+		ExcludeTags: q.ExcludeTags,
+		Sorts:       q.Sorts,
+	}
+	if len(q.Filters) > 0 {
+		def.Filters = &FilterExpression{Operator: FilterOperatorAnd, Filters: q.Filters}
+	}
+	return def
+}
+
+// FromDefinition applies a query definition to the builder.
+//
+// Type names are resolved to type keys in the space of the builder. The
+// definition adds to the criteria already set on the builder, except for
+// the limit, offset and maximum number of items, which it replaces when set.
+//
+// Example:
+//
+//	var def anytype.QueryDefinition
+//	if err := json.Unmarshal(data, &def); err != nil {
+//	    log.Fatalf("Invalid query: %v", err)
+//	}
+//
+//	results, err := client.NewQueryBuilder("space123").
+//	    FromDefinition(ctx, &def).
+//	    Execute(ctx)
+func (qb *QueryBuilder) FromDefinition(ctx context.Context, def *QueryDefinition) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+	if def == nil {
+		qb.err = fmt.Errorf("query definition is required: %w", ErrInvalidParameter)
+		return qb
+	}
+	if err := def.Validate(); err != nil {
+		qb.err = fmt.Errorf("invalid query definition: %w", err)
+		return qb
+	}
+
+	if def.Query != "" {
+		qb.WithQuery(def.Query)
+	}
+	qb.WithTypes(ctx, def.Types...)
+	qb.WithTypeKeys(def.TypeKeys...)
+	qb.WithTags(def.Tags...)
+	qb.WithAllTags(def.AllTags...)
+	qb.WithoutTags(def.ExcludeTags...)
+	qb.WithTagIDs(def.TagIDs...)
+	if !def.Filters.IsEmpty() {
+		qb.Where(*def.Filters)
+	}
+	for _, sort := range def.Sorts {
+		qb.WithSort(sort.Property, sort.Direction)
+	}
+	if def.Limit > 0 {
+		qb.WithLimit(def.Limit)
+	}
+	if def.Offset > 0 {
+		qb.WithOffset(def.Offset)
+	}
+	if def.MaxItems > 0 {
+		qb.WithMaxItems(def.MaxItems)
+	}
+	return qb
+}
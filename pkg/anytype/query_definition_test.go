@@ -0,0 +1,143 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestQueryDefinitionRoundTrip tests that a query survives serialization to JSON
+func TestQueryDefinitionRoundTrip(t *testing.T) {
+	var calls int32
+	server, client := setupTypesServer(t, &calls)
+	defer server.Close()
+
+	ctx := context.Background()
+	original := client.NewQueryBuilder("space123").
+		WithQuery("report").
+		WithTypes(ctx, "Task").
+		WithTypeKeys("ot-page").
+		WithTags("work").
+		WithAllTags("urgent").
+		WithoutTags("done").
+		WhereDate("due_date").Before(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)).
+		WhereNumber("priority").GreaterThan(2).
+		WithSort("status", SortAsc).
+		WithSortByUpdatedAt(false).
+		WithLimit(20).
+		WithMaxItems(200)
+
+	def, err := original.Definition()
+	if err != nil {
+		t.Fatalf("Definition failed: %v", err)
+	}
+	if !reflect.DeepEqual(def.Types, []string{"Task"}) || !reflect.DeepEqual(def.TypeKeys, []string{"ot-page"}) {
+		t.Errorf("Expected type names and keys to be kept apart, got %v and %v", def.Types, def.TypeKeys)
+	}
+
+	data, err := json.Marshal(def)
+	if err != nil {
+		t.Fatalf("Failed to marshal definition: %v", err)
+	}
+	var decoded QueryDefinition
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal definition: %v", err)
+	}
+
+	restored := client.NewQueryBuilder("space123").FromDefinition(ctx, &decoded)
+	originalParams, _ := original.GetParams()
+	restoredParams, err := restored.GetParams()
+	if err != nil {
+		t.Fatalf("FromDefinition failed: %v", err)
+	}
+
+	// Filters are grouped once more when applied, and dates are decoded as strings
+	if restoredParams.Filters == nil || len(restoredParams.Filters.Filters) != 1 {
+		t.Fatalf("Unexpected filters: %+v", restoredParams.Filters)
+	}
+	restoredFilters, _ := json.Marshal(restoredParams.Filters.Filters[0])
+	originalFilters, _ := json.Marshal(originalParams.Filters)
+	if string(restoredFilters) != string(originalFilters) {
+		t.Errorf("Unexpected filters:\n%s\nexpected:\n%s", restoredFilters, originalFilters)
+	}
+	restoredParams.Filters, originalParams.Filters = nil, nil
+	if !reflect.DeepEqual(restoredParams, originalParams) {
+		t.Errorf("Unexpected params:\n%+v\nexpected:\n%+v", restoredParams, originalParams)
+	}
+	if restored.maxItems != 200 {
+		t.Errorf("Expected max items 200, got %d", restored.maxItems)
+	}
+}
+
+// TestQueryDefinitionYAML tests that a definition survives serialization to YAML
+func TestQueryDefinitionYAML(t *testing.T) {
+	def := &QueryDefinition{
+		Query:   "report",
+		Types:   []string{"Task"},
+		AllTags: []string{"urgent"},
+		Filters: &FilterExpression{Operator: FilterOperatorAnd, Filters: []FilterExpression{
+			DateProperty("due_date").Before(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+			CheckboxProperty("done").IsFalse(),
+		}},
+		Sorts:    []SortOptions{{Property: SortByName, Direction: SortDesc}},
+		MaxItems: 200,
+	}
+
+	data, err := yaml.Marshal(def)
+	if err != nil {
+		t.Fatalf("Failed to marshal definition: %v", err)
+	}
+	var decoded QueryDefinition
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal definition: %v", err)
+	}
+	if mustJSON(t, decoded) != mustJSON(t, def) {
+		t.Errorf("Unexpected definition after a YAML round trip:\n%s\nexpected:\n%s", mustJSON(t, decoded), mustJSON(t, def))
+	}
+
+	// YAML written by hand uses the JSON field names, and unquoted dates stay strings
+	handwritten := `
+query: report
+all_tags: [urgent]
+filters:
+  operator: and
+  conditions:
+    - property_key: due_date
+      date: 2025-06-01
+      condition: lt
+max_items: 200
+`
+	decoded = QueryDefinition{}
+	if err := yaml.Unmarshal([]byte(handwritten), &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal definition: %v", err)
+	}
+	if decoded.Query != "report" || decoded.MaxItems != 200 || !reflect.DeepEqual(decoded.AllTags, []string{"urgent"}) {
+		t.Errorf("Unexpected definition: %+v", decoded)
+	}
+	if decoded.Filters == nil || len(decoded.Filters.Conditions) != 1 || decoded.Filters.Conditions[0].Value != "2025-06-01" {
+		t.Errorf("Unexpected filters: %s", mustJSON(t, decoded.Filters))
+	}
+}
+
+// TestFromDefinitionInvalid tests that invalid definitions are reported by the builder
+func TestFromDefinitionInvalid(t *testing.T) {
+	client, err := NewClient(WithURL("http://localhost"), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	invalid := []*QueryDefinition{
+		nil,
+		{Limit: -1},
+		{Sorts: []SortOptions{{Property: SortByName, Direction: "sideways"}}},
+	}
+	for _, def := range invalid {
+		if err := client.NewQueryBuilder("space123").FromDefinition(context.Background(), def).Error(); err == nil {
+			t.Errorf("Expected an error for %+v", def)
+		}
+	}
+}
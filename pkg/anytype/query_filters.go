@@ -0,0 +1,212 @@
+package anytype
+
+import "time"
+
+// Where adds property filters to the query.
+// All filters, including those of previous calls, must match.
+//
+// Example:
+//
+//	results, err := client.NewQueryBuilder("space123").
+//	    Where(anytype.Or(
+//	        anytype.NumberProperty("priority").GreaterThan(2),
+//	        anytype.Not(anytype.CheckboxProperty("done").IsTrue()),
+//	    )).
+//	    WhereDate("due_date").Before(time.Now()).
+//	    Execute(ctx)
+func (qb *QueryBuilder) Where(filters ...FilterExpression) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+
+	if qb.params.Filters == nil {
+		qb.params.Filters = &FilterExpression{Operator: FilterOperatorAnd}
+	}
+	qb.params.Filters.Filters = append(qb.params.Filters.Filters, filters...)
+	return qb
+}
+
+// WhereDate starts a filter on the date property with the given key
+func (qb *QueryBuilder) WhereDate(key string) *DateClause {
+	return &DateClause{qb: qb, filter: DateProperty(key)}
+}
+
+// WhereNumber starts a filter on the number property with the given key
+func (qb *QueryBuilder) WhereNumber(key string) *NumberClause {
+	return &NumberClause{qb: qb, filter: NumberProperty(key)}
+}
+
+// WhereCheckbox starts a filter on the checkbox property with the given key
+func (qb *QueryBuilder) WhereCheckbox(key string) *CheckboxClause {
+	return &CheckboxClause{qb: qb, filter: CheckboxProperty(key)}
+}
+
+// WhereSelect starts a filter on the select property with the given key
+func (qb *QueryBuilder) WhereSelect(key string) *SelectClause {
+	return &SelectClause{qb: qb, filter: SelectProperty(key)}
+}
+
+// WhereMultiSelect starts a filter on the multi-select property with the given key
+func (qb *QueryBuilder) WhereMultiSelect(key string) *SelectClause {
+	return &SelectClause{qb: qb, filter: MultiSelectProperty(key)}
+}
+
+// WhereText starts a filter on the text property with the given key
+func (qb *QueryBuilder) WhereText(key string) *TextClause {
+	return &TextClause{qb: qb, filter: TextProperty(key)}
+}
+
+// WhereURL starts a filter on the URL property with the given key
+func (qb *QueryBuilder) WhereURL(key string) *TextClause {
+	return &TextClause{qb: qb, filter: URLProperty(key)}
+}
+
+// WhereEmail starts a filter on the email property with the given key
+func (qb *QueryBuilder) WhereEmail(key string) *TextClause {
+	return &TextClause{qb: qb, filter: EmailProperty(key)}
+}
+
+// DateClause completes a date filter started with QueryBuilder.WhereDate
+type DateClause struct {
+	qb     *QueryBuilder
+	filter DateFilter
+}
+
+// Before matches dates strictly before t
+func (c *DateClause) Before(t time.Time) *QueryBuilder {
+	return c.qb.Where(c.filter.Before(t))
+}
+
+// After matches dates strictly after t
+func (c *DateClause) After(t time.Time) *QueryBuilder {
+	return c.qb.Where(c.filter.After(t))
+}
+
+// On matches dates equal to t
+func (c *DateClause) On(t time.Time) *QueryBuilder {
+	return c.qb.Where(c.filter.On(t))
+}
+
+// IsSet matches objects where the date is set
+func (c *DateClause) IsSet() *QueryBuilder {
+	return c.qb.Where(c.filter.IsSet())
+}
+
+// IsNotSet matches objects where the date is not set
+func (c *DateClause) IsNotSet() *QueryBuilder {
+	return c.qb.Where(c.filter.IsNotSet())
+}
+
+// NumberClause completes a number filter started with QueryBuilder.WhereNumber
+type NumberClause struct {
+	qb     *QueryBuilder
+	filter NumberFilter
+}
+
+// Equals matches numbers equal to n
+func (c *NumberClause) Equals(n float64) *QueryBuilder {
+	return c.qb.Where(c.filter.Equals(n))
+}
+
+// NotEquals matches numbers different from n
+func (c *NumberClause) NotEquals(n float64) *QueryBuilder {
+	return c.qb.Where(c.filter.NotEquals(n))
+}
+
+// GreaterThan matches numbers strictly greater than n
+func (c *NumberClause) GreaterThan(n float64) *QueryBuilder {
+	return c.qb.Where(c.filter.GreaterThan(n))
+}
+
+// GreaterThanOrEqual matches numbers greater than or equal to n
+func (c *NumberClause) GreaterThanOrEqual(n float64) *QueryBuilder {
+	return c.qb.Where(c.filter.GreaterThanOrEqual(n))
+}
+
+// LessThan matches numbers strictly less than n
+func (c *NumberClause) LessThan(n float64) *QueryBuilder {
+	return c.qb.Where(c.filter.LessThan(n))
+}
+
+// LessThanOrEqual matches numbers less than or equal to n
+func (c *NumberClause) LessThanOrEqual(n float64) *QueryBuilder {
+	return c.qb.Where(c.filter.LessThanOrEqual(n))
+}
+
+// CheckboxClause completes a checkbox filter started with QueryBuilder.WhereCheckbox
+type CheckboxClause struct {
+	qb     *QueryBuilder
+	filter CheckboxFilter
+}
+
+// IsTrue matches checked checkboxes
+func (c *CheckboxClause) IsTrue() *QueryBuilder {
+	return c.qb.Where(c.filter.IsTrue())
+}
+
+// IsFalse matches unchecked checkboxes, including unset ones
+func (c *CheckboxClause) IsFalse() *QueryBuilder {
+	return c.qb.Where(c.filter.IsFalse())
+}
+
+// SelectClause completes a select filter started with QueryBuilder.WhereSelect or WhereMultiSelect
+type SelectClause struct {
+	qb     *QueryBuilder
+	filter SelectFilter
+}
+
+// In matches objects having any of the values
+func (c *SelectClause) In(values ...string) *QueryBuilder {
+	return c.qb.Where(c.filter.In(values...))
+}
+
+// NotIn matches objects having none of the values
+func (c *SelectClause) NotIn(values ...string) *QueryBuilder {
+	return c.qb.Where(c.filter.NotIn(values...))
+}
+
+// All matches objects having all of the values
+func (c *SelectClause) All(values ...string) *QueryBuilder {
+	return c.qb.Where(c.filter.All(values...))
+}
+
+// IsSet matches objects where at least one value is selected
+func (c *SelectClause) IsSet() *QueryBuilder {
+	return c.qb.Where(c.filter.IsSet())
+}
+
+// IsNotSet matches objects where no value is selected
+func (c *SelectClause) IsNotSet() *QueryBuilder {
+	return c.qb.Where(c.filter.IsNotSet())
+}
+
+// TextClause completes a text filter started with QueryBuilder.WhereText, WhereURL or WhereEmail
+type TextClause struct {
+	qb     *QueryBuilder
+	filter TextFilter
+}
+
+// Equals matches values equal to s, ignoring case
+func (c *TextClause) Equals(s string) *QueryBuilder {
+	return c.qb.Where(c.filter.Equals(s))
+}
+
+// Contains matches values containing s, ignoring case
+func (c *TextClause) Contains(s string) *QueryBuilder {
+	return c.qb.Where(c.filter.Contains(s))
+}
+
+// NotContains matches values not containing s, ignoring case
+func (c *TextClause) NotContains(s string) *QueryBuilder {
+	return c.qb.Where(c.filter.NotContains(s))
+}
+
+// IsSet matches objects where the value is not empty
+func (c *TextClause) IsSet() *QueryBuilder {
+	return c.qb.Where(c.filter.IsSet())
+}
+
+// IsNotSet matches objects where the value is empty
+func (c *TextClause) IsNotSet() *QueryBuilder {
+	return c.qb.Where(c.filter.IsNotSet())
+}
@@ -0,0 +1,395 @@
+package anytype
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// dateQualifierProperty is the date property compared by the before: and after: qualifiers
+const dateQualifierProperty = "last_modified_date"
+
+// queryQualifiers are the keys of the key:value terms of ParseQuery
+var queryQualifiers = map[string]bool{
+	"type":   true,
+	"tag":    true,
+	"has":    true,
+	"before": true,
+	"after":  true,
+	"sort":   true,
+}
+
+// QueryParseError reports invalid input to ParseQuery.
+//
+// Pos is the byte offset of the offending input, starting at 0.
+type QueryParseError struct {
+	Input string // Query being parsed
+	Pos   int    // Byte offset of the error in Input
+	Msg   string // Description of the error
+}
+
+// Error implements the error interface
+func (e *QueryParseError) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Pos, e.Msg)
+}
+
+// Unwrap returns ErrInvalidParameter so that parse errors are classified as client errors
+func (e *QueryParseError) Unwrap() error {
+	return ErrInvalidParameter
+}
+
+// ParsedQuery is the result of parsing a text query with ParseQuery
+type ParsedQuery struct {
+	Text        string             // Free text search terms
+	Types       []string           // Type names from type: qualifiers
+	Tags        []string           // Tags that objects must all have, from tag: qualifiers
+	ExcludeTags []string           // Tags that objects must not have, from -tag: qualifiers
+	Filters     []FilterExpression // Property filters, all of which must match
+	Sorts       []SortOptions      // Sort directives in order of precedence
+}
+
+// ParseQuery parses a text query into search criteria.
+//
+// A query is a list of terms separated by whitespace. All terms must match.
+//
+//	meeting                 free text, searched by the API
+//	"weekly sync"           quoted phrase, searched as free text
+//	-draft                  excludes objects whose name contains the word
+//	type:Task               objects of the named type (repeat for any of several types)
+//	tag:urgent              objects with the tag (repeat to require several tags)
+//	-tag:done               objects without the tag
+//	has:due_date            objects where the property is set; -has: where it is not
+//	before:2025-06-01       objects last modified before the date; after: for after
+//	due<2025-06-01          property comparison with <, <=, >, >=, = or !=
+//	priority>=2             numbers, dates (YYYY-MM-DD or RFC 3339) and true/false are typed
+//	status="In Progress"    values may be quoted
+//	sort:name               sort directive, ascending; sort:-name or sort:name:desc for descending;
+//	                        repeat to break ties, e.g. sort:status sort:-last_modified_date
+//
+// Other words containing a colon, such as 10:30 or https://example.com, are free text.
+// Any term can be negated with a leading "-". Invalid input returns a *QueryParseError
+// with the position of the error.
+//
+// Example:
+//
+//	parsed, err := anytype.ParseQuery(`type:Task tag:urgent -tag:done due<2025-06-01 meeting`)
+//	if err != nil {
+//	    log.Fatalf("Invalid query: %v", err)
+//	}
+func ParseQuery(input string) (*ParsedQuery, error) {
+	p := &queryParser{input: input}
+	parsed := &ParsedQuery{}
+	var text []string
+
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			break
+		}
+
+		start := p.pos
+		negated := false
+		if p.peek() == '-' && p.pos+1 < len(p.input) && !p.spaceAt(p.pos+1) {
+			negated = true
+			p.pos++
+		}
+
+		// Quoted phrase
+		if p.peek() == '"' {
+			phrase, err := p.readQuoted()
+			if err != nil {
+				return nil, err
+			}
+			if negated {
+				parsed.Filters = append(parsed.Filters, Not(TextProperty("name").Contains(phrase)))
+			} else {
+				text = append(text, phrase)
+			}
+			continue
+		}
+
+		keyPos := p.pos
+		key := p.readKey()
+		op := p.readOperator()
+		if op == "" {
+			// Plain word
+			if key == "" {
+				return nil, p.errorf(start, "empty term")
+			}
+			if negated {
+				parsed.Filters = append(parsed.Filters, Not(TextProperty("name").Contains(key)))
+			} else {
+				text = append(text, key)
+			}
+			continue
+		}
+
+		if key == "" {
+			return nil, p.errorf(keyPos, "missing property name before %q", op)
+		}
+
+		valuePos := p.pos
+		value, err := p.readValue()
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			return nil, p.errorf(valuePos, "missing value after %q", key+op)
+		}
+
+		if op == ":" {
+			err = p.applyQualifier(parsed, key, value, negated, keyPos, valuePos)
+		} else {
+			err = p.applyComparison(parsed, key, op, value, negated, valuePos)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parsed.Text = strings.Join(text, " ")
+	return parsed, nil
+}
+
+// queryParser holds the state of ParseQuery
+type queryParser struct {
+	input string
+	pos   int
+}
+
+// errorf returns a parse error at the given position
+func (p *queryParser) errorf(pos int, format string, args ...interface{}) error {
+	return &QueryParseError{Input: p.input, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// peek returns the byte at the current position, 0 at the end of the input
+func (p *queryParser) peek() byte {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// spaceAt reports whether the rune at the given position is whitespace
+func (p *queryParser) spaceAt(pos int) bool {
+	if pos >= len(p.input) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(p.input[pos:])
+	return isQuerySpace(r)
+}
+
+// advance moves past the rune at the current position
+func (p *queryParser) advance() {
+	_, width := utf8.DecodeRuneInString(p.input[p.pos:])
+	p.pos += width
+}
+
+// skipSpaces advances past whitespace
+func (p *queryParser) skipSpaces() {
+	for p.spaceAt(p.pos) {
+		p.advance()
+	}
+}
+
+// readKey reads a word or property name up to whitespace, a quote or an operator.
+// A colon only ends the key after a qualifier, other words containing a colon
+// are read up to whitespace or a quote.
+func (p *queryParser) readKey() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == ':' && p.pos > start && !queryQualifiers[strings.ToLower(p.input[start:p.pos])] {
+			for p.pos < len(p.input) && !p.spaceAt(p.pos) && p.input[p.pos] != '"' {
+				p.advance()
+			}
+			break
+		}
+		if p.spaceAt(p.pos) || c == '"' || c == ':' || c == '<' || c == '>' || c == '=' {
+			break
+		}
+		if c == '!' && p.pos+1 < len(p.input) && p.input[p.pos+1] == '=' {
+			break
+		}
+		p.advance()
+	}
+	return p.input[start:p.pos]
+}
+
+// readOperator reads a qualifier or comparison operator, returning "" if there is none
+func (p *queryParser) readOperator() string {
+	for _, op := range []string{"<=", ">=", "!=", ":", "<", ">", "="} {
+		if strings.HasPrefix(p.input[p.pos:], op) {
+			p.pos += len(op)
+			return op
+		}
+	}
+	return ""
+}
+
+// readValue reads a quoted or unquoted value up to whitespace
+func (p *queryParser) readValue() (string, error) {
+	if p.peek() == '"' {
+		return p.readQuoted()
+	}
+	start := p.pos
+	for p.pos < len(p.input) && !p.spaceAt(p.pos) {
+		if p.input[p.pos] == '"' {
+			return "", p.errorf(p.pos, "unexpected quote inside value")
+		}
+		p.advance()
+	}
+	return p.input[start:p.pos], nil
+}
+
+// readQuoted reads a quoted string starting at the current position
+func (p *queryParser) readQuoted() (string, error) {
+	start := p.pos
+	end := strings.IndexByte(p.input[start+1:], '"')
+	if end < 0 {
+		return "", p.errorf(start, "unterminated quote")
+	}
+	p.pos = start + 1 + end + 1
+	if p.pos < len(p.input) && !p.spaceAt(p.pos) {
+		return "", p.errorf(p.pos, "expected whitespace after closing quote")
+	}
+	return p.input[start+1 : start+1+end], nil
+}
+
+// applyQualifier applies a key:value term
+func (p *queryParser) applyQualifier(parsed *ParsedQuery, key, value string, negated bool, keyPos, valuePos int) error {
+	switch strings.ToLower(key) {
+	case "type":
+		if negated {
+			return p.errorf(keyPos-1, "type: cannot be negated")
+		}
+		parsed.Types = append(parsed.Types, value)
+	case "tag":
+		if negated {
+			parsed.ExcludeTags = append(parsed.ExcludeTags, value)
+		} else {
+			parsed.Tags = append(parsed.Tags, value)
+		}
+	case "has":
+		filter := condition(value, "", FilterConditionNotEmpty, nil)
+		if negated {
+			filter = Not(filter)
+		}
+		parsed.Filters = append(parsed.Filters, filter)
+	case "before", "after":
+		date, ok := parseFilterDate(value)
+		if !ok {
+			return p.errorf(valuePos, "invalid date %q, expected YYYY-MM-DD or RFC 3339", value)
+		}
+		filter := DateProperty(dateQualifierProperty).Before(date)
+		if strings.ToLower(key) == "after" {
+			filter = DateProperty(dateQualifierProperty).After(date)
+		}
+		if negated {
+			filter = Not(filter)
+		}
+		parsed.Filters = append(parsed.Filters, filter)
+	case "sort":
+		if negated {
+			return p.errorf(keyPos-1, "sort: cannot be negated")
+		}
+		sort, err := p.parseSort(value, valuePos)
+		if err != nil {
+			return err
+		}
+		parsed.Sorts = append(parsed.Sorts, *sort)
+	}
+	return nil
+}
+
+// parseSort parses the value of a sort: directive
+func (p *queryParser) parseSort(value string, valuePos int) (*SortOptions, error) {
+	direction := SortAsc
+	if strings.HasPrefix(value, "-") {
+		direction = SortDesc
+		value = value[1:]
+		valuePos++
+	}
+	if i := strings.LastIndexByte(value, ':'); i >= 0 {
+		switch strings.ToLower(value[i+1:]) {
+		case "asc":
+			direction = SortAsc
+		case "desc":
+			direction = SortDesc
+		default:
+			return nil, p.errorf(valuePos+i+1, "invalid sort direction %q, expected asc or desc", value[i+1:])
+		}
+		value = value[:i]
+	}
+	if value == "" {
+		return nil, p.errorf(valuePos, "missing sort property")
+	}
+	return &SortOptions{Property: SortProperty(value), Direction: direction}, nil
+}
+
+// comparisonConditions maps comparison operators to filter conditions
+var comparisonConditions = map[string]FilterConditionType{
+	"=":  FilterConditionEqual,
+	"!=": FilterConditionNotEqual,
+	"<":  FilterConditionLess,
+	"<=": FilterConditionLessOrEqual,
+	">":  FilterConditionGreater,
+	">=": FilterConditionGreaterOrEqual,
+}
+
+// applyComparison applies a property comparison term such as priority>=2
+func (p *queryParser) applyComparison(parsed *ParsedQuery, key, op, value string, negated bool, valuePos int) error {
+	cond := comparisonConditions[op]
+	ordered := cond != FilterConditionEqual && cond != FilterConditionNotEqual
+
+	var filter FilterExpression
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		filter = condition(key, PropertyFormatNumber, cond, n)
+	} else if date, ok := parseFilterDate(value); ok {
+		filter = condition(key, PropertyFormatDate, cond, date)
+	} else if b, err := strconv.ParseBool(value); err == nil && !ordered {
+		filter = condition(key, PropertyFormatCheckbox, cond, b)
+	} else if ordered {
+		return p.errorf(valuePos, "operator %q requires a number or a date, got %q", op, value)
+	} else {
+		filter = condition(key, PropertyFormatText, cond, value)
+	}
+
+	if negated {
+		filter = Not(filter)
+	}
+	parsed.Filters = append(parsed.Filters, filter)
+	return nil
+}
+
+// isQuerySpace reports whether c separates query terms
+func isQuerySpace(c rune) bool {
+	return unicode.IsSpace(c)
+}
+
+// FromExpression applies a text query parsed with ParseQuery to the builder.
+//
+// Type names are resolved to type keys. Parse errors are reported by the
+// builder as a *QueryParseError.
+//
+// Example:
+//
+//	results, err := client.NewQueryBuilder("space123").
+//	    FromExpression(ctx, `type:Task tag:urgent -tag:done due<2025-06-01 meeting`).
+//	    Execute(ctx)
+func (qb *QueryBuilder) FromExpression(ctx context.Context, expr string) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+
+	parsed, err := ParseQuery(expr)
+	if err != nil {
+		qb.err = err
+		return qb
+	}
+
+	return qb.FromDefinition(ctx, parsed.Definition())
+}
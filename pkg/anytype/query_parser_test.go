@@ -0,0 +1,164 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestParseQuery tests parsing of the query language
+func TestParseQuery(t *testing.T) {
+	parsed, err := ParseQuery(`type:Task tag:urgent -tag:done due<2025-06-01 meeting "weekly sync" -draft priority>=2 status="In Progress" has:owner -has:blocker before:2025-01-01 sort:-name`)
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+
+	if parsed.Text != "meeting weekly sync" {
+		t.Errorf("Unexpected text: %q", parsed.Text)
+	}
+	if !reflect.DeepEqual(parsed.Types, []string{"Task"}) {
+		t.Errorf("Unexpected types: %v", parsed.Types)
+	}
+	if !reflect.DeepEqual(parsed.Tags, []string{"urgent"}) || !reflect.DeepEqual(parsed.ExcludeTags, []string{"done"}) {
+		t.Errorf("Unexpected tags: %v, excluded %v", parsed.Tags, parsed.ExcludeTags)
+	}
+	if !reflect.DeepEqual(parsed.Sorts, []SortOptions{{Property: SortByName, Direction: SortDesc}}) {
+		t.Errorf("Unexpected sort: %+v", parsed.Sorts)
+	}
+
+	due := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	expected := []FilterExpression{
+		DateProperty("due").Before(due),
+		Not(TextProperty("name").Contains("draft")),
+		NumberProperty("priority").GreaterThanOrEqual(2),
+		TextProperty("status").Equals("In Progress"),
+		condition("owner", "", FilterConditionNotEmpty, nil),
+		Not(condition("blocker", "", FilterConditionNotEmpty, nil)),
+		DateProperty(dateQualifierProperty).Before(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	if !reflect.DeepEqual(parsed.Filters, expected) {
+		t.Errorf("Unexpected filters:\n%+v\nexpected:\n%+v", parsed.Filters, expected)
+	}
+}
+
+// TestParseQueryUnicode tests that non-ASCII text is not split into several terms
+func TestParseQueryUnicode(t *testing.T) {
+	parsed, err := ParseQuery("voilà")
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	if parsed.Text != "voilà" {
+		t.Errorf("Unexpected text: %q", parsed.Text)
+	}
+
+	parsed, err = ParseQuery("Åsa tag:café")
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	if parsed.Text != "Åsa" {
+		t.Errorf("Unexpected text: %q", parsed.Text)
+	}
+	if !reflect.DeepEqual(parsed.Tags, []string{"café"}) {
+		t.Errorf("Unexpected tags: %v", parsed.Tags)
+	}
+
+	// Non-ASCII whitespace still separates terms
+	parsed, err = ParseQuery("tag:café\u00a0-tag:thé")
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	if !reflect.DeepEqual(parsed.Tags, []string{"café"}) || !reflect.DeepEqual(parsed.ExcludeTags, []string{"thé"}) {
+		t.Errorf("Unexpected tags: %v, excluded %v", parsed.Tags, parsed.ExcludeTags)
+	}
+}
+
+// TestParseQueryColons tests that words containing a colon are text unless they start with a qualifier
+func TestParseQueryColons(t *testing.T) {
+	parsed, err := ParseQuery(`meeting 10:30 https://example.com/a?b=c Type:Task color:red -note:draft`)
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	if parsed.Text != "meeting 10:30 https://example.com/a?b=c color:red" {
+		t.Errorf("Unexpected text: %q", parsed.Text)
+	}
+	if !reflect.DeepEqual(parsed.Types, []string{"Task"}) {
+		t.Errorf("Unexpected types: %v", parsed.Types)
+	}
+	expected := []FilterExpression{Not(TextProperty("name").Contains("note:draft"))}
+	if !reflect.DeepEqual(parsed.Filters, expected) {
+		t.Errorf("Unexpected filters: %+v", parsed.Filters)
+	}
+
+	// Comparisons still apply to property names
+	parsed, err = ParseQuery(`start>=2025-06-01T10:30:00Z`)
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	expected = []FilterExpression{condition("start", PropertyFormatDate, FilterConditionGreaterOrEqual, time.Date(2025, 6, 1, 10, 30, 0, 0, time.UTC))}
+	if !reflect.DeepEqual(parsed.Filters, expected) {
+		t.Errorf("Unexpected filters:\n%+v\nexpected:\n%+v", parsed.Filters, expected)
+	}
+}
+
+// TestParseQueryErrors tests that invalid queries report the position of the error
+func TestParseQueryErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+	}{
+		{`meeting "weekly sync`, 8},
+		{`tag:`, 4},
+		{`priority>high`, 9},
+		{`:value`, 0},
+		{`-type:Task`, 0},
+		{`before:tomorrow`, 7},
+		{`sort:name:sideways`, 10},
+		{`tag:"a"b`, 7},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseQuery(tt.input)
+		var parseErr *QueryParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%q: expected a QueryParseError, got %v", tt.input, err)
+			continue
+		}
+		if parseErr.Pos != tt.pos {
+			t.Errorf("%q: expected error at position %d, got %d (%v)", tt.input, tt.pos, parseErr.Pos, err)
+		}
+		if !errors.Is(err, ErrInvalidParameter) {
+			t.Errorf("%q: expected the error to wrap ErrInvalidParameter", tt.input)
+		}
+	}
+}
+
+// TestFromExpression tests applying a parsed query to a query builder
+func TestFromExpression(t *testing.T) {
+	var calls int32
+	server, client := setupTypesServer(t, &calls)
+	defer server.Close()
+
+	params, err := client.NewQueryBuilder("space123").
+		FromExpression(context.Background(), `type:Note tag:work "project plan" sort:status sort:-name`).
+		GetParams()
+	if err != nil {
+		t.Fatalf("FromExpression failed: %v", err)
+	}
+
+	if params.Query != "project plan" || len(params.Types) != 1 || params.Types[0] != "ot-note" {
+		t.Errorf("Unexpected params: %+v", params)
+	}
+	if !reflect.DeepEqual(params.AllTags, []string{"work"}) {
+		t.Errorf("Unexpected tags: %v", params.AllTags)
+	}
+	expectedSorts := []SortOptions{{Property: "status", Direction: SortAsc}, {Property: SortByName, Direction: SortDesc}}
+	if !reflect.DeepEqual(params.Sorts, expectedSorts) {
+		t.Errorf("Unexpected sorts: %+v", params.Sorts)
+	}
+
+	if err := client.NewQueryBuilder("space123").FromExpression(context.Background(), `due<`).Error(); err == nil {
+		t.Error("Expected a parse error")
+	}
+}
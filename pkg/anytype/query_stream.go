@@ -0,0 +1,183 @@
+package anytype
+
+import (
+	"context"
+)
+
+// defaultHydrationWorkers is the number of objects fetched concurrently by WithHydration
+const defaultHydrationWorkers = 4
+
+// WithHydration makes Stream fetch the full version of each object, including
+// its blocks and all properties, instead of the search result.
+//
+// Up to workers objects are fetched concurrently, 4 if workers is not positive.
+// Objects are still streamed in the order of the search results.
+func (qb *QueryBuilder) WithHydration(workers int) *QueryBuilder {
+	if qb.err != nil {
+		return qb
+	}
+
+	if workers <= 0 {
+		workers = defaultHydrationWorkers
+	}
+	qb.hydrationWorkers = workers
+	return qb
+}
+
+// Stream runs the search and streams all matching objects over a channel.
+//
+// Result pages are fetched lazily as the consumer receives objects: the
+// objects channel is unbuffered, so a slow consumer slows down the fetching.
+// The limit set with WithLimit is used as the page size and the number of
+// objects can be capped with WithMaxItems.
+//
+// Both channels are closed when the stream ends. The error channel receives
+// at most one error, including the context error if ctx is canceled before
+// all objects are streamed. The consumer must either receive all objects or
+// cancel ctx to release the streaming goroutine.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(ctx)
+//	defer cancel()
+//
+//	objects, errs := client.NewQueryBuilder("space123").
+//	    WithQuery("meeting").
+//	    WithHydration(8).
+//	    Stream(ctx)
+//
+//	for obj := range objects {
+//	    fmt.Println(obj.Name)
+//	}
+//	if err := <-errs; err != nil {
+//	    log.Fatalf("Search failed: %v", err)
+//	}
+func (qb *QueryBuilder) Stream(ctx context.Context) (<-chan Object, <-chan error) {
+	objects := make(chan Object)
+	errs := make(chan error, 1)
+
+	pager, err := qb.Pager()
+	if err != nil {
+		errs <- err
+		close(objects)
+		close(errs)
+		return objects, errs
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(objects)
+
+		// Apply custom timeout if specified
+		if qb.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, qb.timeout)
+			defer cancel()
+		}
+
+		var err error
+		if qb.hydrationWorkers > 0 {
+			err = qb.streamHydrated(ctx, pager, objects)
+		} else {
+			err = pager.ForEach(ctx, func(obj Object) error {
+				return sendObject(ctx, objects, obj)
+			})
+		}
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return objects, errs
+}
+
+// sendObject sends an object to the stream unless ctx is canceled first
+func sendObject(ctx context.Context, objects chan<- Object, obj Object) error {
+	select {
+	case objects <- obj:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// hydrationResult is the outcome of fetching the full version of an object
+type hydrationResult struct {
+	obj Object
+	err error
+}
+
+// streamHydrated streams the full version of each object returned by the pager.
+//
+// Objects are fetched by a bounded pool of goroutines while previous objects
+// are being consumed. The results are queued in search order, so a slow
+// object delays the following ones but the number of fetched objects waiting
+// to be consumed never exceeds the number of workers.
+func (qb *QueryBuilder) streamHydrated(ctx context.Context, pager *Pager[Object], objects chan<- Object) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pending := make(chan chan hydrationResult, qb.hydrationWorkers)
+	slots := make(chan struct{}, qb.hydrationWorkers)
+
+	// Start fetching objects as they are listed by the pager
+	var listErr error
+	listed := make(chan struct{})
+	go func() {
+		defer close(listed)
+		defer close(pending)
+		listErr = pager.ForEach(ctx, func(obj Object) error {
+			result := make(chan hydrationResult, 1)
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			go func() {
+				select {
+				case slots <- struct{}{}:
+					defer func() { <-slots }()
+				case <-ctx.Done():
+					result <- hydrationResult{err: ctx.Err()}
+					return
+				}
+				result <- qb.hydrate(ctx, obj)
+			}()
+			return nil
+		})
+	}()
+
+	// Stream the fetched objects in order
+	for result := range pending {
+		r := <-result
+		if r.err == nil {
+			r.err = sendObject(ctx, objects, r.obj)
+		}
+		if r.err != nil {
+			cancel()
+			<-listed
+			return r.err
+		}
+	}
+
+	<-listed
+	return listErr
+}
+
+// hydrate fetches the full version of a search result
+func (qb *QueryBuilder) hydrate(ctx context.Context, obj Object) hydrationResult {
+	spaceID := obj.SpaceID
+	if spaceID == "" {
+		spaceID = qb.spaceID
+	}
+
+	full, err := qb.client.GetObject(ctx, &GetObjectParams{SpaceID: spaceID, ObjectID: obj.ID})
+	if err != nil {
+		return hydrationResult{err: err}
+	}
+	if full.SpaceID == "" {
+		full.SpaceID = spaceID
+	}
+	return hydrationResult{obj: *full}
+}
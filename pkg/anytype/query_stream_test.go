@@ -0,0 +1,216 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupStreamServer serves count search results and the full version of each object
+func setupStreamServer(t *testing.T, count int, searches, inFlight, maxInFlight *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			current := atomic.AddInt32(inFlight, 1)
+			defer atomic.AddInt32(inFlight, -1)
+			for {
+				max := atomic.LoadInt32(maxInFlight)
+				if current <= max || atomic.CompareAndSwapInt32(maxInFlight, max, current) {
+					break
+				}
+			}
+
+			// Later objects are faster to fetch, to check that the order is kept
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			var i int
+			fmt.Sscanf(id, "obj%d", &i)
+			time.Sleep(time.Duration(count-i) * time.Millisecond)
+
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"object": Object{ID: id, Blocks: []Block{{ID: "block-" + id}}},
+			})
+			return
+		}
+
+		atomic.AddInt32(searches, 1)
+		var body SearchRequestBody
+		json.NewDecoder(r.Body).Decode(&body)
+		end := body.Offset + body.Limit
+		if end > count {
+			end = count
+		}
+		objects := make([]Object, 0)
+		for i := body.Offset; i < end; i++ {
+			objects = append(objects, Object{ID: fmt.Sprintf("obj%d", i)})
+		}
+		json.NewEncoder(w).Encode(SearchResponse{
+			Data:       objects,
+			Pagination: Pagination{Total: count, Offset: body.Offset, Limit: body.Limit, HasMore: end < count},
+		})
+	}))
+}
+
+// TestStream tests that all pages are streamed in order
+func TestStream(t *testing.T) {
+	var searches, inFlight, maxInFlight int32
+	server := setupStreamServer(t, 25, &searches, &inFlight, &maxInFlight)
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	objects, errs := client.NewQueryBuilder("space123").WithLimit(10).Stream(context.Background())
+	i := 0
+	for obj := range objects {
+		if obj.ID != fmt.Sprintf("obj%d", i) {
+			t.Errorf("Expected obj%d, got %s", i, obj.ID)
+		}
+		i++
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Stream failed: %v", err)
+	}
+	if i != 25 || searches != 3 {
+		t.Errorf("Expected 25 objects in 3 pages, got %d objects in %d pages", i, searches)
+	}
+}
+
+// TestStreamHydration tests fetching full objects with a bounded worker pool
+func TestStreamHydration(t *testing.T) {
+	var searches, inFlight, maxInFlight int32
+	server := setupStreamServer(t, 30, &searches, &inFlight, &maxInFlight)
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	objects, errs := client.NewQueryBuilder("space123").WithLimit(10).WithHydration(3).Stream(context.Background())
+	i := 0
+	for obj := range objects {
+		id := fmt.Sprintf("obj%d", i)
+		if obj.ID != id || len(obj.Blocks) != 1 || obj.Blocks[0].ID != "block-"+id {
+			t.Errorf("Expected hydrated %s, got %+v", id, obj)
+		}
+		if obj.SpaceID != "space123" {
+			t.Errorf("Expected space ID to be set, got %q", obj.SpaceID)
+		}
+		i++
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Stream failed: %v", err)
+	}
+	if i != 30 {
+		t.Errorf("Expected 30 objects, got %d", i)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("Expected at most 3 concurrent fetches, got %d", maxInFlight)
+	}
+}
+
+// TestStreamCancel tests that canceling the context stops the stream
+func TestStreamCancel(t *testing.T) {
+	for _, workers := range []int{0, 2} {
+		var searches, inFlight, maxInFlight int32
+		server := setupStreamServer(t, 100, &searches, &inFlight, &maxInFlight)
+
+		client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		qb := client.NewQueryBuilder("space123").WithLimit(10)
+		if workers > 0 {
+			qb.WithHydration(workers)
+		}
+		objects, errs := qb.Stream(ctx)
+
+		received := 0
+		for range objects {
+			received++
+			if received == 3 {
+				cancel()
+				break
+			}
+		}
+
+		// The stream ends without the remaining objects being consumed
+		select {
+		case err := <-errs:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("workers=%d: expected context.Canceled, got %v", workers, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("workers=%d: stream did not stop after cancellation", workers)
+		}
+		if searches > 2 {
+			t.Errorf("workers=%d: expected pages to be fetched lazily, got %d pages", workers, searches)
+		}
+
+		cancel()
+		server.Close()
+	}
+}
+
+// TestStreamInvalidQuery tests that builder errors are reported on the error channel
+func TestStreamInvalidQuery(t *testing.T) {
+	client, err := NewClient(WithURL("http://localhost"), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	objects, errs := client.NewQueryBuilder("space123").WithLimit(-1).Stream(context.Background())
+	if _, ok := <-objects; ok {
+		t.Error("Expected no objects")
+	}
+	if err := <-errs; err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+// TestStreamClientFilter tests that streaming client-side filtered results scans each server page once
+func TestStreamClientFilter(t *testing.T) {
+	var searches int32
+	server := setupTaggedSearchServer(t, 1000, func(body map[string]interface{}) {
+		atomic.AddInt32(&searches, 1)
+	})
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	for _, sorted := range []bool{false, true} {
+		atomic.StoreInt32(&searches, 0)
+		qb := client.NewQueryBuilder("space123").WithTags("important").WithLimit(10)
+		if sorted {
+			// Several sort keys are sorted client-side
+			qb = qb.WithSortByName(true).WithSortByCreatedAt(false)
+		}
+
+		objects, errs := qb.Stream(context.Background())
+		count := 0
+		for range objects {
+			count++
+		}
+		if err := <-errs; err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+
+		// 334 matches are streamed in 34 pages from 10 server pages
+		if count != 334 || searches != 10 {
+			t.Errorf("Expected 334 objects from 10 server pages (sorted: %v), got %d objects from %d pages", sorted, count, searches)
+		}
+	}
+}
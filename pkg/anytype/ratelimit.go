@@ -0,0 +1,154 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits the rate of API requests made by the client.
+//
+// The limiter is a token bucket refilled at rps tokens per second that holds
+// up to burst tokens. It is shared by every endpoint of the client, so one
+// client can safely be used from many goroutines without overwhelming the
+// local Anytype API. Retries also consume tokens. A non-positive rps disables
+// rate limiting.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithRateLimit(20, 5), // 20 requests per second, bursts of 5
+//	)
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		if rps <= 0 {
+			c.rateLimiter = nil
+			return
+		}
+		c.rateLimiter = newRateLimiter(rps, burst)
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of API requests in flight at once.
+//
+// Requests beyond the limit wait for a free slot until their context is done.
+// A non-positive value disables the limit.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithMaxConcurrentRequests(4),
+//	)
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *Client) {
+		if n <= 0 {
+			c.requestSlots = nil
+			return
+		}
+		c.requestSlots = make(chan struct{}, n)
+	}
+}
+
+// rateLimiter is a token bucket rate limiter safe for concurrent use
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64   // Tokens added per second
+	burst  float64   // Maximum number of tokens
+	tokens float64   // Currently available tokens, negative when reserved ahead
+	last   time.Time // Last time tokens were refilled
+}
+
+// newRateLimiter creates a rate limiter with a full bucket
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		// Give the reserved token back so other requests are not delayed
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token and returns how long the caller has to wait for it
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed > 0 {
+		l.tokens += elapsed * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// acquireRequestSlot waits for the rate limiter and a free concurrency slot.
+//
+// The returned function must be called to release the slot once the request is done.
+func (c *Client) acquireRequestSlot(ctx context.Context, path string) (func(), error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, waitError(path, "rate limiter", err)
+		}
+	}
+
+	if c.requestSlots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.requestSlots <- struct{}{}:
+		return func() { <-c.requestSlots }, nil
+	case <-ctx.Done():
+		return nil, waitError(path, "a free request slot", ctx.Err())
+	}
+}
+
+// waitError wraps a context error returned while waiting before a request
+func waitError(path, waitingFor string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return WrapError(path, 0, "request timed out waiting for "+waitingFor, ErrOperationTimeout)
+	}
+	return WrapError(path, 0, "request canceled waiting for "+waitingFor, err)
+}
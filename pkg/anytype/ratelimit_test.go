@@ -0,0 +1,122 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestMaxConcurrentRequests tests that the number of requests in flight is capped
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			peak := atomic.LoadInt32(&maxInFlight)
+			if current <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"object": {"id": "obj123", "name": "Test Object"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithURL(server.URL),
+		WithAppKey("test-app-key"),
+		WithMaxConcurrentRequests(2),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			params := &GetObjectParams{SpaceID: "space123", ObjectID: "obj123"}
+			if _, err := client.GetObject(context.Background(), params); err != nil {
+				t.Errorf("GetObject failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("Expected at most 2 concurrent requests, got %d", got)
+	}
+}
+
+// TestConcurrencySlotRespectsContext tests that waiting for a request slot stops when the context is done
+func TestConcurrencySlotRespectsContext(t *testing.T) {
+	client, err := NewClient(WithAppKey("test-app-key"), WithMaxConcurrentRequests(1))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Occupy the only slot
+	release, err := client.acquireRequestSlot(context.Background(), "/test")
+	if err != nil {
+		t.Fatalf("Failed to acquire slot: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.acquireRequestSlot(ctx, "/test"); !errors.Is(err, ErrOperationTimeout) {
+		t.Fatalf("Expected a timeout error, got: %v", err)
+	}
+}
+
+// TestRateLimiterReserve tests the token bucket accounting
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := newRateLimiter(10, 2)
+	now := limiter.last
+
+	// The burst is available immediately
+	for i := 0; i < 2; i++ {
+		if delay := limiter.reserve(now); delay != 0 {
+			t.Fatalf("Expected no delay for burst token %d, got %v", i, delay)
+		}
+	}
+
+	// The next token is available after 1/rps
+	if delay := limiter.reserve(now); delay != 100*time.Millisecond {
+		t.Fatalf("Expected 100ms delay, got %v", delay)
+	}
+
+	// Tokens are refilled over time
+	if delay := limiter.reserve(now.Add(300 * time.Millisecond)); delay != 0 {
+		t.Fatalf("Expected no delay after refill, got %v", delay)
+	}
+}
+
+// TestRateLimiterWaitRespectsContext tests that waiting for a token stops when the context is done
+func TestRateLimiterWaitRespectsContext(t *testing.T) {
+	limiter := newRateLimiter(1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Expected first token immediately, got: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("Expected an error when the context expires")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("Wait did not return promptly: %v", elapsed)
+	}
+}
@@ -0,0 +1,176 @@
+package anytype
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Repository reads and writes the objects of a type as Go values.
+//
+// Values are converted to and from objects with Marshal and Unmarshal, so T
+// must be a struct with anytype struct tags, and a field tagged "id" to be
+// updated. The key of the type is resolved by name the first time it is
+// needed, and all searches are scoped to that type. A Repository is safe for
+// concurrent use.
+//
+// Example:
+//
+//	incidents := anytype.NewRepository[Incident](client, "space123", "Incident")
+//
+//	open, err := incidents.Find(ctx, anytype.CheckboxProperty("resolved").IsFalse())
+//	if err != nil {
+//	    log.Fatalf("Failed to find incidents: %v", err)
+//	}
+//	for _, incident := range open {
+//	    fmt.Println(incident.Title)
+//	}
+type Repository[T any] struct {
+	client   *Client
+	spaceID  string
+	typeName string
+
+	mu      sync.Mutex
+	typeKey string // Resolved type key, empty until resolved
+}
+
+// NewRepository creates a Repository over the objects of the type with the
+// given name in a space
+func NewRepository[T any](client *Client, spaceID, typeName string) *Repository[T] {
+	return &Repository[T]{client: client, spaceID: spaceID, typeName: typeName}
+}
+
+// TypeKey returns the key of the type of the repository, resolving it on first use.
+// Failed lookups are not cached, so they are retried on the next call.
+func (r *Repository[T]) TypeKey(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.typeKey != "" {
+		return r.typeKey, nil
+	}
+	typeKey, err := r.client.GetTypeByName(ctx, r.spaceID, r.typeName)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve type %s: %w", r.typeName, err)
+	}
+	r.typeKey = typeKey
+	return typeKey, nil
+}
+
+// Get returns the object with the given ID.
+// Objects of another type are reported as not found.
+func (r *Repository[T]) Get(ctx context.Context, objectID string) (*T, error) {
+	typeKey, err := r.TypeKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := r.client.GetObject(ctx, &GetObjectParams{SpaceID: r.spaceID, ObjectID: objectID})
+	if err != nil {
+		return nil, err
+	}
+	if obj.Type == nil || obj.Type.Key != typeKey {
+		return nil, fmt.Errorf("%w: object %s is not a %s", ErrObjectNotFound, objectID, r.typeName)
+	}
+	return r.decode(obj)
+}
+
+// List returns all objects of the type
+func (r *Repository[T]) List(ctx context.Context) ([]T, error) {
+	return r.search(ctx, NewSearchParams())
+}
+
+// Find returns the objects of the type matching a filter expression
+func (r *Repository[T]) Find(ctx context.Context, filter FilterExpression) ([]T, error) {
+	params := NewSearchParams()
+	params.Filters = &filter
+	return r.search(ctx, params)
+}
+
+// Create creates an object from a value and returns the value of the created object
+func (r *Repository[T]) Create(ctx context.Context, value T) (*T, error) {
+	typeKey, err := r.TypeKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	obj.ID = ""
+	obj.Type = &TypeInfo{Key: typeKey}
+
+	created, err := r.client.createObject(ctx, r.spaceID, obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := Unmarshal(created, &value); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// Update writes a value to the object with the ID held by its field tagged
+// "id", and returns the value of the updated object
+func (r *Repository[T]) Update(ctx context.Context, value T) (*T, error) {
+	typeKey, err := r.TypeKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if obj.ID == "" {
+		return nil, fmt.Errorf("%w: %T has no ID, tag a field with anytype:\"id\"", ErrInvalidObjectID, value)
+	}
+	obj.Type = &TypeInfo{Key: typeKey}
+
+	updated, err := r.client.UpdateObject(ctx, r.spaceID, obj.ID, obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := Unmarshal(updated, &value); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// Delete deletes the object with the given ID
+func (r *Repository[T]) Delete(ctx context.Context, objectID string) error {
+	return r.client.DeleteObject(ctx, r.spaceID, objectID)
+}
+
+// search returns the values of all objects of the type matching the search parameters
+func (r *Repository[T]) search(ctx context.Context, params *SearchParams) ([]T, error) {
+	typeKey, err := r.TypeKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params.Types = []string{typeKey}
+
+	values := []T{}
+	err = r.client.SearchPager(r.spaceID, params).ForEach(ctx, func(obj Object) error {
+		value, err := r.decode(&obj)
+		if err != nil {
+			return err
+		}
+		values = append(values, *value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// decode converts an object to a value of the repository type
+func (r *Repository[T]) decode(obj *Object) (*T, error) {
+	var value T
+	if err := Unmarshal(obj, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode object %s: %w", obj.ID, err)
+	}
+	return &value, nil
+}
@@ -0,0 +1,173 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// repositoryIncident is a struct stored with a Repository
+type repositoryIncident struct {
+	ID       string `anytype:"id"`
+	Title    string `anytype:"name"`
+	Severity string `anytype:"severity,select"`
+	Resolved bool   `anytype:"resolved"`
+}
+
+// setupRepositoryServer sets up a mock server with an incident type, a note and two incidents
+func setupRepositoryServer(t *testing.T, typeRequests *int32, requests *[]recordedRequest) (*httptest.Server, *Client) {
+	incidents := []Object{
+		{ID: "obj1", Name: "Outage", Type: &TypeInfo{Key: "incident"}, Properties: []Property{
+			{Key: "severity", Format: "select", Select: &PropertyTag{Name: "SEV1"}},
+			{Key: "resolved", Format: "checkbox", Checkbox: false},
+		}},
+		{ID: "obj2", Name: "Slow page", Type: &TypeInfo{Key: "incident"}, Properties: []Property{
+			{Key: "resolved", Format: "checkbox", Checkbox: true},
+		}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		request := recordedRequest{Method: r.Method, Path: r.URL.Path}
+		if r.ContentLength != 0 {
+			json.NewDecoder(r.Body).Decode(&request.Body)
+		}
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/types"):
+			atomic.AddInt32(typeRequests, 1)
+			w.Write([]byte(`{"data": [{"key": "ot-note", "name": "Note"}, {"key": "incident", "name": "Incident"}], "pagination": {"total": 2}}`))
+			return
+		case strings.HasSuffix(r.URL.Path, "/search"):
+			*requests = append(*requests, request)
+			json.NewEncoder(w).Encode(SearchResponse{Data: incidents, Pagination: Pagination{Total: len(incidents)}})
+			return
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/objects/note1"):
+			w.Write([]byte(`{"object": {"id": "note1", "name": "Notes", "type": {"key": "ot-note"}}}`))
+			return
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]Object{"object": incidents[0]})
+			return
+		}
+
+		*requests = append(*requests, request)
+		w.Write([]byte(`{"object": {"id": "obj3", "name": "Created", "type": {"key": "incident"}}}`))
+	}))
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return server, client
+}
+
+// TestRepositoryRead tests getting, listing and finding values scoped to a type
+func TestRepositoryRead(t *testing.T) {
+	var typeRequests int32
+	var requests []recordedRequest
+	server, client := setupRepositoryServer(t, &typeRequests, &requests)
+	defer server.Close()
+	ctx := context.Background()
+
+	incidents := NewRepository[repositoryIncident](client, "space123", "Incident")
+
+	incident, err := incidents.Get(ctx, "obj1")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if incident.ID != "obj1" || incident.Title != "Outage" || incident.Severity != "SEV1" || incident.Resolved {
+		t.Errorf("Unexpected incident: %+v", incident)
+	}
+	if _, err := incidents.Get(ctx, "note1"); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("Expected ErrObjectNotFound for an object of another type, got %v", err)
+	}
+
+	all, err := incidents.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(all) != 2 || all[1].Title != "Slow page" || !all[1].Resolved {
+		t.Errorf("Unexpected incidents: %+v", all)
+	}
+
+	open, err := incidents.Find(ctx, CheckboxProperty("resolved").IsFalse())
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+	if len(open) != 1 || open[0].ID != "obj1" {
+		t.Errorf("Expected only the open incident, got %+v", open)
+	}
+
+	for _, request := range requests {
+		types, _ := request.Body["types"].([]interface{})
+		if len(types) != 1 || types[0] != "incident" {
+			t.Errorf("Expected searches to be scoped to the incident type, got %v", request.Body)
+		}
+	}
+	if typeRequests != 1 {
+		t.Errorf("Expected the type key to be resolved once, got %d type requests", typeRequests)
+	}
+}
+
+// TestRepositoryWrite tests creating, updating and deleting values
+func TestRepositoryWrite(t *testing.T) {
+	var typeRequests int32
+	var requests []recordedRequest
+	server, client := setupRepositoryServer(t, &typeRequests, &requests)
+	defer server.Close()
+	ctx := context.Background()
+
+	incidents := NewRepository[repositoryIncident](client, "space123", "Incident")
+
+	created, err := incidents.Create(ctx, repositoryIncident{Title: "Created", Severity: "SEV2"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if created.ID != "obj3" || created.Severity != "SEV2" {
+		t.Errorf("Unexpected created incident: %+v", created)
+	}
+
+	if _, err := incidents.Update(ctx, repositoryIncident{ID: "obj1", Title: "Outage", Resolved: false}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if _, err := incidents.Update(ctx, repositoryIncident{Title: "No ID"}); !errors.Is(err, ErrInvalidObjectID) {
+		t.Errorf("Expected ErrInvalidObjectID without an ID, got %v", err)
+	}
+	if err := incidents.Delete(ctx, "obj1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	expected := []string{"POST /v1/spaces/space123/objects", "PUT /v1/spaces/space123/objects/obj1", "DELETE /v1/spaces/space123/objects/obj1"}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected %d requests, got %+v", len(expected), requests)
+	}
+	for i, request := range requests {
+		if request.Method+" "+request.Path != expected[i] {
+			t.Errorf("Expected %s, got %s %s", expected[i], request.Method, request.Path)
+		}
+	}
+
+	createType, _ := requests[0].Body["type"].(map[string]interface{})
+	if createType["key"] != "incident" || requests[0].Body["id"] != nil {
+		t.Errorf("Expected the object to be created with the incident type, got %v", requests[0].Body)
+	}
+
+	// The unchecked checkbox is sent on update
+	found := false
+	properties, _ := requests[1].Body["properties"].([]interface{})
+	for _, property := range properties {
+		prop := property.(map[string]interface{})
+		if prop["key"] == "resolved" {
+			checkbox, ok := prop["checkbox"]
+			found = ok && checkbox == false
+		}
+	}
+	if !found {
+		t.Errorf("Expected resolved to be sent as false, got %v", properties)
+	}
+}
@@ -0,0 +1,202 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retry defaults used by DefaultRetryPolicy
+const (
+	defaultRetryAttempts   = 3
+	defaultRetryBackoff    = 200 * time.Millisecond
+	defaultRetryMaxBackoff = 5 * time.Second
+	defaultRetryMultiplier = 2.0
+	defaultRetryJitter     = 0.2
+)
+
+// RetryPolicy configures automatic retries of failed API requests.
+//
+// Requests are retried with exponential backoff and jitter. When the server
+// answers 429 or 503 with a Retry-After header, the advertised delay is used
+// instead of the computed backoff. By default only idempotent HTTP methods
+// (GET, HEAD, OPTIONS, PUT, DELETE) and search requests are retried.
+//
+// Example:
+//
+//	policy := anytype.DefaultRetryPolicy()
+//	policy.MaxAttempts = 5
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithAppKey("your-app-key"),
+//	    anytype.WithRetryPolicy(policy),
+//	)
+type RetryPolicy struct {
+	MaxAttempts        int              // Total number of attempts including the first one
+	InitialBackoff     time.Duration    // Delay before the first retry
+	MaxBackoff         time.Duration    // Upper bound for the computed backoff
+	Multiplier         float64          // Factor applied to the backoff after each attempt
+	Jitter             float64          // Random fraction (0-1) subtracted from each backoff
+	RetryNonIdempotent bool             // Whether POST and PATCH requests may also be retried
+	Retryable          func(error) bool // Decides whether an error is retryable; defaults to IsRetryableError
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most applications.
+//
+// It makes up to 3 attempts, starting with a 200ms backoff that doubles after
+// each attempt, capped at 5 seconds, with 20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    defaultRetryAttempts,
+		InitialBackoff: defaultRetryBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+		Multiplier:     defaultRetryMultiplier,
+		Jitter:         defaultRetryJitter,
+	}
+}
+
+// WithRetryPolicy enables automatic retries of transient failures.
+//
+// By default the client does not retry failed requests. Use DefaultRetryPolicy
+// as a starting point and adjust the fields you need. The number of attempts
+// made is reported in the Attempts field of the returned *Error.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithRetryPolicy(anytype.DefaultRetryPolicy()),
+//	)
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// IsRetryableError checks if an error is transient and the request may be retried.
+//
+// Server errors, timeouts, network errors and rate limiting are considered retryable.
+func IsRetryableError(err error) bool {
+	return IsServerError(err) ||
+		errors.Is(err, ErrNetworkError) ||
+		errors.Is(err, ErrRateLimited)
+}
+
+// maxAttempts returns the effective number of attempts for the policy
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry checks if a failed request may be attempted again
+func (p RetryPolicy) shouldRetry(ctx context.Context, method string, err error) bool {
+	// Never retry once the caller gave up
+	if ctx.Err() != nil {
+		return false
+	}
+	if !p.RetryNonIdempotent && !isIdempotentMethod(method) && !isIdempotentRequest(ctx) {
+		return false
+	}
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryableError(err)
+}
+
+// backoff computes the delay before the given retry (1-based)
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	if p.InitialBackoff <= 0 {
+		return 0
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// isIdempotentMethod checks if an HTTP method is idempotent
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// idempotentKey marks requests that are safe to retry regardless of their method
+type idempotentKey struct{}
+
+// markIdempotent marks the request made with ctx as safe to retry.
+// It is used for read-only endpoints that use POST, such as search.
+func markIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// isIdempotentRequest checks if the request was marked as safe to retry
+func isIdempotentRequest(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentKey{}).(bool)
+	return idempotent
+}
+
+// parseRetryAfter parses a Retry-After header value given in seconds or as an HTTP date.
+//
+// The returned boolean reports whether the value was valid. A value of 0 or
+// a date in the past is valid and means the request can be retried right away.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,236 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestClient creates a client with a fast retry policy pointing to the given server
+func newRetryTestClient(t *testing.T, serverURL string, maxAttempts int) *Client {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	client, err := NewClient(
+		WithURL(serverURL),
+		WithAppKey("test-app-key"),
+		WithRetryPolicy(policy),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// TestRetryOnServerError tests that idempotent requests are retried on server errors
+func TestRetryOnServerError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message": "busy"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"space": {"id": "space123", "name": "Test Space"}}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 3)
+
+	space, err := client.GetSpaceByID(context.Background(), "space123")
+	if err != nil {
+		t.Fatalf("GetSpaceByID failed: %v", err)
+	}
+	if space.ID != "space123" {
+		t.Fatalf("Unexpected space: %+v", space)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Fatalf("Expected 3 calls, got %d", got)
+	}
+}
+
+// TestRetryExhausted tests that the attempt count is reported once retries are exhausted
+func TestRetryExhausted(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 4)
+
+	_, err := client.GetSpaceByID(context.Background(), "space123")
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if !IsServerError(err) {
+		t.Fatalf("Expected a server error, got: %v", err)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	if apiErr.Attempts != 4 {
+		t.Fatalf("Expected 4 attempts, got %d", apiErr.Attempts)
+	}
+	if got := atomic.LoadInt32(&calls); got != 4 {
+		t.Fatalf("Expected 4 calls, got %d", got)
+	}
+}
+
+// TestNoRetryForNonIdempotentMethods tests that POST requests are not retried by default
+func TestNoRetryForNonIdempotentMethods(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 3)
+
+	object := &Object{ID: "temp-id", Name: "New Object", Type: &TypeInfo{Key: "ot-note"}}
+	if _, err := client.CreateObject(context.Background(), "space123", object); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("Expected 1 call, got %d", got)
+	}
+}
+
+// TestRetryRateLimited tests that 429 responses are retried and search requests are retryable
+func TestRetryRateLimited(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [], "pagination": {"total": 0}}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 2)
+
+	if _, err := client.Search(context.Background(), "space123", NewSearchParams()); err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("Expected 2 calls, got %d", got)
+	}
+}
+
+// TestRetryAfterZero tests that an explicit Retry-After of 0 replaces the backoff
+func TestRetryAfterZero(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"space": {"id": "space123", "name": "Test Space"}}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 2)
+	client.retryPolicy.InitialBackoff = time.Minute
+	client.retryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.GetSpaceByID(ctx, "space123"); err != nil {
+		t.Fatalf("GetSpaceByID failed: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("Expected 2 calls, got %d", got)
+	}
+}
+
+// TestRetryCanceledDuringBackoff tests that the context error is returned when it ends the backoff
+func TestRetryCanceledDuringBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"message": "busy"}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(t, server.URL, 3)
+	client.retryPolicy.InitialBackoff = time.Minute
+	client.retryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.GetSpaceByID(ctx, "space123")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if errors.Is(err, ErrServerError) {
+		t.Errorf("Expected the context error instead of the error of the last attempt, got %v", err)
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Attempts != 1 {
+		t.Errorf("Expected the error to report 1 attempt, got %+v", apiErr)
+	}
+}
+
+// TestParseRetryAfter tests parsing of Retry-After header values
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 4, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Duration
+		valid    bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"0", 0, true},
+		{"-1", 0, false},
+		{"invalid", 0, false},
+		{now.Add(10 * time.Second).Format(http.TimeFormat), 10 * time.Second, true},
+		{now.Add(-10 * time.Second).Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tt := range tests {
+		if got, valid := parseRetryAfter(tt.value, now); got != tt.expected || valid != tt.valid {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, expected %v, %v", tt.value, got, valid, tt.expected, tt.valid)
+		}
+	}
+}
+
+// TestRetryBackoff tests that the backoff grows exponentially and is capped
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}
+	for i, want := range expected {
+		if got := policy.backoff(i + 1); got != want {
+			t.Errorf("backoff(%d) = %v, expected %v", i+1, got, want)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		got := policy.backoff(1)
+		if got < 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Fatalf("Jittered backoff out of range: %v", got)
+		}
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"strings"
 )
 
+// filterScanPageSize is the page size used to scan results filtered client-side
+const filterScanPageSize = 100
+
 // validateSearchParams validates search parameters
 func (c *Client) validateSearchParams(spaceID string, params *SearchParams) error {
 	if spaceID == "" {
@@ -23,8 +26,9 @@ func (c *Client) validateSearchParams(spaceID string, params *SearchParams) erro
 	return nil
 }
 
-// prepareSearchRequest prepares the search request body
-func (c *Client) prepareSearchRequest(spaceID string, params *SearchParams) (*SearchRequestBody, []string) {
+// prepareSearchRequest prepares the search request body and the predicate
+// matching objects that must be filtered client-side, nil if none
+func (c *Client) prepareSearchRequest(spaceID string, params *SearchParams) (*SearchRequestBody, func(Object) bool) {
 	// Create search request body according to API spec
 	requestBody := &SearchRequestBody{
 		Query:   params.Query,
@@ -33,26 +37,54 @@ func (c *Client) prepareSearchRequest(spaceID string, params *SearchParams) (*Se
 		SpaceID: spaceID,
 	}
 
-	// Save the tags for post-filtering
-	requestedTags := params.Tags
-
 	// Add non-empty type strings
 	c.addTypeFilters(requestBody, params.Types)
 
-	// Include tags if present - the API may or may not handle them natively
-	if len(requestedTags) > 0 {
-		requestBody.Tags = requestedTags
-
-		// Increase limit for tag filtering to ensure we get enough matches
-		c.adjustLimitForTagFiltering(requestBody, requestedTags)
+	// Filter on the server when supported, otherwise client-side
+	var matchers []func(Object) bool
+	serverFilter := &FilterExpression{Operator: FilterOperatorAnd}
+	if hasTagFilters(params) {
+		if c.serverFilters {
+			serverFilter.Filters = append(serverFilter.Filters, *tagFilter(params))
+		} else {
+			matchers = append(matchers, c.tagMatcher(params))
+		}
+	}
+	if !params.Filters.IsEmpty() {
+		if rewritten, ok := params.Filters.withoutNegation(false); ok && c.serverFilters {
+			serverFilter.Filters = append(serverFilter.Filters, rewritten)
+		} else {
+			matchers = append(matchers, params.Filters.Matches)
+		}
+	}
+	switch {
+	case len(serverFilter.Filters) == 1:
+		requestBody.Filters = &serverFilter.Filters[0]
+	case !serverFilter.IsEmpty():
+		requestBody.Filters = serverFilter
 	}
 
-	// Set sort options if provided
-	if params.Sort != nil {
-		requestBody.Sort = params.Sort
+	// The API sorts by a single property, further keys are applied client-side
+	if sorts := params.sortKeys(); len(sorts) > 0 {
+		requestBody.Sort = &sorts[0]
 	}
 
-	return requestBody, requestedTags
+	return requestBody, allMatch(matchers)
+}
+
+// allMatch returns a predicate matching objects that match all predicates, nil if there are none
+func allMatch(matchers []func(Object) bool) func(Object) bool {
+	if len(matchers) == 0 {
+		return nil
+	}
+	return func(obj Object) bool {
+		for _, match := range matchers {
+			if !match(obj) {
+				return false
+			}
+		}
+		return true
+	}
 }
 
 // addTypeFilters adds type filters to the search request
@@ -73,19 +105,17 @@ func (c *Client) addTypeFilters(requestBody *SearchRequestBody, types []string)
 	}
 }
 
-// adjustLimitForTagFiltering increases the limit for tag filtering
-func (c *Client) adjustLimitForTagFiltering(requestBody *SearchRequestBody, tags []string) {
-	if len(tags) > 0 && requestBody.Limit < 1000 {
-		if c.debug && c.logger != nil {
-			c.logger.Debug("Increasing search limit for tag filtering: %d -> 1000", requestBody.Limit)
-		}
-		requestBody.Limit = 1000
-	}
-}
-
 // executeSearch executes the search request and parses the response
 func (c *Client) executeSearch(ctx context.Context, spaceID string, requestBody *SearchRequestBody, params *SearchParams) (*SearchResponse, error) {
+	// Search does not modify anything, so it is safe to retry despite using POST
+	ctx = markIdempotent(ctx)
 	path := fmt.Sprintf("/v1/spaces/%s/search", spaceID)
+	if spaceID == "" {
+		path = "/v1/search"
+		ctx = withOperation(ctx, "SearchAll", path)
+	} else {
+		ctx = withOperation(ctx, "Search", "/v1/spaces/{space_id}/search")
+	}
 
 	body, err := json.Marshal(requestBody)
 	if err != nil {
@@ -130,53 +160,99 @@ func (c *Client) isEmptyResponse(data []byte) bool {
 	return len(data) == 0 || string(data) == "{}" || string(data) == "[]"
 }
 
-// postProcessSearchResults processes search results (extracts tags and filters by tags)
-func (c *Client) postProcessSearchResults(response *SearchResponse, requestedTags []string) {
-	// Extract tags from all objects
+// extractSearchTags extracts the tags of all objects in the response
+func (c *Client) extractSearchTags(response *SearchResponse) {
 	for i := range response.Data {
 		extractTags(&response.Data[i])
 		if c.debug && c.logger != nil {
 			c.logger.Debug("Object '%s' has tags: %v", response.Data[i].Name, response.Data[i].Tags)
 		}
 	}
+}
 
-	// Apply tag filtering if requested
-	if len(requestedTags) > 0 {
-		c.filterObjectsByTags(response, requestedTags)
-	}
+// hasTagFilters reports whether the search parameters filter by tags
+func hasTagFilters(params *SearchParams) bool {
+	return len(params.Tags) > 0 || len(params.AllTags) > 0 || len(params.ExcludeTags) > 0 || len(params.TagIDs) > 0
 }
 
-// filterObjectsByTags filters objects by the requested tags
-func (c *Client) filterObjectsByTags(response *SearchResponse, requestedTags []string) {
-	if c.debug && c.logger != nil {
-		c.logger.Debug("Filtering %d objects by tags: %v", len(response.Data), requestedTags)
+// tagFilter returns the server-side filter matching the tag filters of the search parameters
+func tagFilter(params *SearchParams) *FilterExpression {
+	filter := &FilterExpression{Operator: FilterOperatorAnd}
+	addCondition := func(condition FilterConditionType, tags []string) {
+		if len(tags) > 0 {
+			filter.Conditions = append(filter.Conditions, FilterCondition{
+				PropertyKey: "tag",
+				Format:      PropertyFormatMultiSelect,
+				Condition:   condition,
+				Value:       tags,
+			})
+		}
 	}
 
-	filteredObjects := make([]Object, 0)
+	addCondition(FilterConditionIn, params.Tags)
+	addCondition(FilterConditionAll, params.AllTags)
+	addCondition(FilterConditionNotIn, params.ExcludeTags)
+	addCondition(FilterConditionIn, params.TagIDs)
+	return filter
+}
 
-	// Filter objects that contain ANY of the requested tags
-	for _, obj := range response.Data {
-		if c.objectMatchesAnyTag(obj, requestedTags) {
-			filteredObjects = append(filteredObjects, obj)
+// tagMatcher returns a predicate matching objects against all tag filters of the search parameters
+func (c *Client) tagMatcher(params *SearchParams) func(Object) bool {
+	return func(obj Object) bool {
+		if len(params.Tags) > 0 && !c.objectMatchesAnyTag(obj, params.Tags) {
+			return false
 		}
+		if len(params.AllTags) > 0 && !c.objectMatchesAllTags(obj, params.AllTags) {
+			return false
+		}
+		if len(params.ExcludeTags) > 0 && c.objectMatchesAnyTag(obj, params.ExcludeTags) {
+			return false
+		}
+		if len(params.TagIDs) > 0 && !objectHasAnyTagID(obj, params.TagIDs) {
+			return false
+		}
+		return true
 	}
-
-	// Update response with filtered objects and fix pagination
-	if c.debug && c.logger != nil {
-		c.logger.Debug("Tag filtering reduced results: %d -> %d", len(response.Data), len(filteredObjects))
-	}
-	response.Data = filteredObjects
-	response.Pagination.Total = len(filteredObjects)
 }
 
 // objectMatchesAnyTag checks if an object matches any of the requested tags
 func (c *Client) objectMatchesAnyTag(obj Object, requestedTags []string) bool {
 	for _, requestedTag := range requestedTags {
-		for _, objTag := range obj.Tags {
-			if strings.EqualFold(requestedTag, objTag) {
-				if c.debug && c.logger != nil {
-					c.logger.Debug("Object '%s' matches tag '%s' with '%s'", obj.Name, requestedTag, objTag)
-				}
+		if objectHasTag(obj, requestedTag) {
+			if c.debug && c.logger != nil {
+				c.logger.Debug("Object '%s' matches tag '%s'", obj.Name, requestedTag)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// objectMatchesAllTags checks if an object has all of the requested tags
+func (c *Client) objectMatchesAllTags(obj Object, requestedTags []string) bool {
+	for _, requestedTag := range requestedTags {
+		if !objectHasTag(obj, requestedTag) {
+			return false
+		}
+	}
+	return true
+}
+
+// objectHasTag checks if an object has a tag, comparing names case-insensitively
+func objectHasTag(obj Object, tag string) bool {
+	for _, objTag := range obj.Tags {
+		if strings.EqualFold(tag, objTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectHasAnyTagID checks if an object has any of the tag IDs
+func objectHasAnyTagID(obj Object, tagIDs []string) bool {
+	for _, id := range objectTagIDs(obj) {
+		for _, tagID := range tagIDs {
+			if id == tagID {
 				return true
 			}
 		}
@@ -184,6 +260,116 @@ func (c *Client) objectMatchesAnyTag(obj Object, requestedTags []string) bool {
 	return false
 }
 
+// objectTagIDs returns the IDs of the tags of an object
+func objectTagIDs(obj Object) []string {
+	var ids []string
+	if obj.Relations != nil {
+		for _, relation := range obj.Relations.Items["tags"] {
+			if relation.ID != "" {
+				ids = append(ids, relation.ID)
+			}
+		}
+	}
+	for _, prop := range obj.Properties {
+		if !isTagProperty(prop) {
+			continue
+		}
+		for _, tag := range prop.MultiSelect {
+			if tag.ID != "" {
+				ids = append(ids, tag.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// searchCursor is the progress of a search filtered or sorted client-side.
+//
+// A SearchPager keeps its cursor across pages, so that each page continues
+// scanning the server results where the previous one stopped instead of
+// scanning them again from the start.
+type searchCursor struct {
+	scanned  int      // Number of server results scanned
+	skipped  int      // Number of matches before the first buffered one
+	buffered []Object // Matches scanned but not returned yet
+	done     bool     // Whether all server results were scanned
+	sorted   bool     // Whether the buffered matches were sorted client-side
+}
+
+// searchWithClientFilter pages through the search results and filters them client-side.
+//
+// A nil match keeps all objects. When sorts are given, all matching objects
+// are fetched and sorted client-side before the window is applied, which
+// requires keeping them in memory.
+//
+// Offset and Limit are applied to the matching objects, and the returned
+// pagination describes the filtered results. Scanning continues from cursor
+// when the offset doesn't go back before it. Without sorts, scanning stops
+// after the page holding the first match past the window: HasMore is then
+// true and Total only counts the matches scanned so far.
+func (c *Client) searchWithClientFilter(ctx context.Context, spaceID string, requestBody *SearchRequestBody, params *SearchParams, match func(Object) bool, sorts []SortOptions, cursor *searchCursor) (*SearchResponse, error) {
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if params.Offset < cursor.skipped {
+		*cursor = searchCursor{}
+	}
+
+	// Without sorts, the window is complete once a match past it was found
+	for !cursor.done && (len(sorts) > 0 || cursor.skipped+len(cursor.buffered) <= params.Offset+limit) {
+		pageBody := *requestBody
+		pageBody.Offset = cursor.scanned
+		pageBody.Limit = filterScanPageSize
+
+		page, err := c.executeSearch(ctx, spaceID, &pageBody, params)
+		if err != nil {
+			return nil, err
+		}
+		c.extractSearchTags(page)
+
+		for _, obj := range page.Data {
+			if match == nil || match(obj) {
+				cursor.buffered = append(cursor.buffered, obj)
+			}
+		}
+		cursor.scanned += len(page.Data)
+		cursor.done = !page.Pagination.HasMore || len(page.Data) == 0
+	}
+
+	if c.debug && c.logger != nil {
+		c.logger.Debug("Client-side filtering matched %d of %d objects", cursor.skipped+len(cursor.buffered), cursor.scanned)
+	}
+
+	if len(sorts) > 0 && !cursor.sorted {
+		sortObjects(cursor.buffered, sorts)
+		cursor.sorted = true
+	}
+
+	// Drop the matches before the window, then take the window
+	drop := params.Offset - cursor.skipped
+	if drop > len(cursor.buffered) {
+		drop = len(cursor.buffered)
+	}
+	cursor.skipped += drop
+	cursor.buffered = cursor.buffered[drop:]
+
+	window := windowObjects(cursor.buffered, 0, limit)
+	cursor.skipped += len(window)
+	cursor.buffered = cursor.buffered[len(window):]
+
+	total := cursor.skipped + len(cursor.buffered)
+	return &SearchResponse{
+		Data: window,
+		Pagination: Pagination{
+			Total:   total,
+			Offset:  params.Offset,
+			Limit:   limit,
+			HasMore: params.Offset+len(window) < total,
+		},
+	}, nil
+}
+
 // ensureSearchPagination ensures pagination is properly set
 func (c *Client) ensureSearchPagination(response *SearchResponse, params *SearchParams) {
 	if response.Pagination.Limit == 0 {
@@ -203,7 +389,15 @@ func (c *Client) ensureSearchPagination(response *SearchResponse, params *Search
 // parameters will be used. The search results include objects matching the criteria and any
 // related metadata.
 //
-// Tag filtering is performed client-side after retrieving the results from the API.
+// Tag and property filtering is performed client-side by paging through the results from the API,
+// unless the client was created with WithServerSideFilters. In both cases, Offset and
+// Limit apply to the filtered results and the returned pagination describes them.
+// Client-side filtering stops paging once the requested window is filled, so
+// Total is only exact when HasMore is false.
+//
+// The API sorts by a single property. When several sort keys are given, results are
+// sorted by the first one on the server, then all results are fetched and sorted
+// client-side by all keys, keeping the server order of equal objects.
 //
 // Example:
 //
@@ -234,8 +428,31 @@ func (c *Client) Search(ctx context.Context, spaceID string, params *SearchParam
 		return nil, err
 	}
 
+	if params == nil {
+		params = NewSearchParams()
+	}
+
+	return c.search(ctx, spaceID, params)
+}
+
+// search runs a validated search in a space, or across all spaces if spaceID is empty
+func (c *Client) search(ctx context.Context, spaceID string, params *SearchParams) (*SearchResponse, error) {
+	return c.searchFrom(ctx, spaceID, params, &searchCursor{})
+}
+
+// searchFrom runs a validated search, continuing a client-side filtered scan from cursor
+func (c *Client) searchFrom(ctx context.Context, spaceID string, params *SearchParams, cursor *searchCursor) (*SearchResponse, error) {
 	// Prepare search request
-	requestBody, requestedTags := c.prepareSearchRequest(spaceID, params)
+	requestBody, match := c.prepareSearchRequest(spaceID, params)
+
+	// Page through the results when they must be filtered or sorted client-side
+	var clientSorts []SortOptions
+	if sorts := params.sortKeys(); len(sorts) > 1 {
+		clientSorts = sorts
+	}
+	if match != nil || clientSorts != nil {
+		return c.searchWithClientFilter(ctx, spaceID, requestBody, params, match, clientSorts, cursor)
+	}
 
 	// Execute search request and parse response
 	response, err := c.executeSearch(ctx, spaceID, requestBody, params)
@@ -243,8 +460,7 @@ func (c *Client) Search(ctx context.Context, spaceID string, params *SearchParam
 		return nil, err
 	}
 
-	// Post-process results (extract and filter tags)
-	c.postProcessSearchResults(response, requestedTags)
+	c.extractSearchTags(response)
 
 	// Ensure pagination is properly set
 	c.ensureSearchPagination(response, params)
@@ -0,0 +1,188 @@
+package anytype
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// searchAllConcurrency is the number of spaces searched concurrently when fanning out
+const searchAllConcurrency = 4
+
+// SearchAll searches objects across all spaces.
+//
+// It queries the global search endpoint. If the API doesn't provide it, every
+// space is searched concurrently instead and the results are merged. In both
+// cases, each returned object has its SpaceID set, and Offset and Limit apply
+// to the merged results.
+//
+// Merged results are sorted by the sort options of params, or by last modification date,
+// most recent first, when no sort is requested.
+//
+// When some spaces fail to be searched, the results of the other spaces are
+// returned along with a *SpaceSearchError listing the failed spaces. The
+// search only fails without results when every space fails, or when ctx is
+// done.
+//
+// Example:
+//
+//	results, err := client.SearchAll(ctx, &anytype.SearchParams{Query: "invoice", Limit: 20})
+//	var spaceErr *anytype.SpaceSearchError
+//	if errors.As(err, &spaceErr) {
+//	    log.Printf("Some spaces were skipped: %v", err)
+//	} else if err != nil {
+//	    log.Fatalf("Search failed: %v", err)
+//	}
+//
+//	for _, obj := range results.Data {
+//	    fmt.Printf("%s (space %s)\n", obj.Name, obj.SpaceID)
+//	}
+func (c *Client) SearchAll(ctx context.Context, params *SearchParams) (*SearchResponse, error) {
+	if params == nil {
+		params = NewSearchParams()
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/search", 0, "invalid search parameters", err)
+	}
+
+	if !c.noGlobalSearch.Load() {
+		response, err := c.search(ctx, "", params)
+		if err == nil {
+			return response, nil
+		}
+		if !IsNotFoundError(err) {
+			return nil, err
+		}
+
+		// Remember the endpoint is missing to avoid trying it again
+		c.noGlobalSearch.Store(true)
+		if c.debug && c.logger != nil {
+			c.logger.Debug("Global search endpoint not available, searching each space")
+		}
+	}
+
+	return c.searchEachSpace(ctx, params)
+}
+
+// searchEachSpace searches every space concurrently and merges the results
+func (c *Client) searchEachSpace(ctx context.Context, params *SearchParams) (*SearchResponse, error) {
+	spaces, err := c.SpacesPager().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	// Each space must return enough objects to fill the requested window once merged
+	spaceParams := *params
+	spaceParams.Offset = 0
+	spaceParams.Limit = params.Offset + limit
+
+	responses := make([]*SearchResponse, len(spaces))
+	errs := make([]error, len(spaces))
+	slots := make(chan struct{}, searchAllConcurrency)
+	var wg sync.WaitGroup
+	for i := range spaces {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			responses[i], errs[i] = c.search(ctx, spaces[i].ID, &spaceParams)
+		}(i)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	merged := make([]Object, 0)
+	total := 0
+	var spaceErr *SpaceSearchError
+	for i, response := range responses {
+		if errs[i] != nil {
+			if spaceErr == nil {
+				spaceErr = &SpaceSearchError{Errors: make(map[string]error)}
+			}
+			spaceErr.Errors[spaces[i].ID] = errs[i]
+			if c.debug && c.logger != nil {
+				c.logger.Debug("Skipping space %s after a failed search: %v", spaces[i].ID, errs[i])
+			}
+			continue
+		}
+		for _, obj := range response.Data {
+			if obj.SpaceID == "" {
+				obj.SpaceID = spaces[i].ID
+			}
+			merged = append(merged, obj)
+		}
+		total += response.Pagination.Total
+	}
+
+	sorts := params.sortKeys()
+	if len(sorts) == 0 {
+		sorts = []SortOptions{defaultSort}
+	}
+	sortObjects(merged, sorts)
+
+	// Apply the global window
+	window := windowObjects(merged, params.Offset, limit)
+	response := &SearchResponse{
+		Data: window,
+		Pagination: Pagination{
+			Total:   total,
+			Offset:  params.Offset,
+			Limit:   limit,
+			HasMore: params.Offset+len(window) < total,
+		},
+	}
+	if spaceErr != nil {
+		if len(spaceErr.Errors) == len(spaces) {
+			return nil, spaceErr
+		}
+		return response, spaceErr
+	}
+	return response, nil
+}
+
+// SpaceSearchError reports the spaces that failed to be searched by SearchAll.
+// The results of the other spaces are returned with it.
+type SpaceSearchError struct {
+	Errors map[string]error // Search errors by space ID
+}
+
+// Error implements the error interface
+func (e *SpaceSearchError) Error() string {
+	spaceIDs := make([]string, 0, len(e.Errors))
+	for spaceID := range e.Errors {
+		spaceIDs = append(spaceIDs, spaceID)
+	}
+	sort.Strings(spaceIDs)
+
+	messages := make([]string, len(spaceIDs))
+	for i, spaceID := range spaceIDs {
+		messages[i] = fmt.Sprintf("space %s: %v", spaceID, e.Errors[spaceID])
+	}
+	return fmt.Sprintf("failed to search %d spaces: %s", len(spaceIDs), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of the failed spaces
+func (e *SpaceSearchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
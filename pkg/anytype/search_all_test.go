@@ -0,0 +1,155 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// setupSearchAllServer serves two spaces, optionally without the global search endpoint
+func setupSearchAllServer(t *testing.T, global bool, globalCalls *int32) *httptest.Server {
+	dates := map[string][]string{
+		"space1": {"2025-03-01", "2025-01-01", "2024-12-01"},
+		"space2": {"2025-04-01", "2025-02-01"},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/search":
+			atomic.AddInt32(globalCalls, 1)
+			if !global {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"data": [{"id": "g1", "space_id": "space9"}], "pagination": {"total": 1}}`))
+		case "/v1/spaces":
+			w.Write([]byte(`{"data": [{"id": "space1"}, {"id": "space2"}], "pagination": {"total": 2}}`))
+		case "/v1/spaces/space1/search", "/v1/spaces/space2/search":
+			var body SearchRequestBody
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Offset != 0 || body.Limit != 3 {
+				t.Errorf("Expected each space to be searched with offset 0 and limit 3, got %d/%d", body.Offset, body.Limit)
+			}
+
+			spaceID := r.URL.Path[len("/v1/spaces/") : len(r.URL.Path)-len("/search")]
+			objects := []Object{}
+			for i, date := range dates[spaceID] {
+				objects = append(objects, Object{
+					ID:         fmt.Sprintf("%s-obj%d", spaceID, i),
+					Properties: []Property{{Key: "last_modified_date", Format: "date", Date: date + "T00:00:00Z"}},
+				})
+			}
+			json.NewEncoder(w).Encode(SearchResponse{Data: objects, Pagination: Pagination{Total: len(objects)}})
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+}
+
+// TestSearchAllFanOut tests merging of per-space results when the global endpoint is missing
+func TestSearchAllFanOut(t *testing.T) {
+	var globalCalls int32
+	server := setupSearchAllServer(t, false, &globalCalls)
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		results, err := client.SearchAll(context.Background(), &SearchParams{Offset: 1, Limit: 2})
+		if err != nil {
+			t.Fatalf("SearchAll failed: %v", err)
+		}
+
+		// Most recent first: space2-obj0, space1-obj0, space2-obj1, space1-obj1, space1-obj2
+		if len(results.Data) != 2 || results.Data[0].ID != "space1-obj0" || results.Data[1].ID != "space2-obj1" {
+			t.Fatalf("Unexpected merged results: %+v", results.Data)
+		}
+		if results.Data[0].SpaceID != "space1" || results.Data[1].SpaceID != "space2" {
+			t.Errorf("Objects were not annotated with their space: %+v", results.Data)
+		}
+		expected := Pagination{Total: 5, Offset: 1, Limit: 2, HasMore: true}
+		if results.Pagination != expected {
+			t.Errorf("Expected pagination %+v, got %+v", expected, results.Pagination)
+		}
+	}
+
+	if globalCalls != 1 {
+		t.Errorf("Expected the global endpoint to be tried once, got %d", globalCalls)
+	}
+}
+
+// TestSearchAllGlobalEndpoint tests that the global endpoint is used when available
+func TestSearchAllGlobalEndpoint(t *testing.T) {
+	var globalCalls int32
+	server := setupSearchAllServer(t, true, &globalCalls)
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	results, err := client.SearchAll(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("SearchAll failed: %v", err)
+	}
+	if len(results.Data) != 1 || results.Data[0].SpaceID != "space9" || globalCalls != 1 {
+		t.Fatalf("Unexpected results: %+v", results.Data)
+	}
+}
+
+// TestSearchAllPartialFailure tests that spaces failing to be searched are reported with the other results
+func TestSearchAllPartialFailure(t *testing.T) {
+	failing := map[string]bool{"space2": true}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/search":
+			w.WriteHeader(http.StatusNotFound)
+		case "/v1/spaces":
+			w.Write([]byte(`{"data": [{"id": "space1"}, {"id": "space2"}], "pagination": {"total": 2}}`))
+		case "/v1/spaces/space1/search", "/v1/spaces/space2/search":
+			spaceID := r.URL.Path[len("/v1/spaces/") : len(r.URL.Path)-len("/search")]
+			if failing[spaceID] {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"message": "access denied"}`))
+				return
+			}
+			w.Write([]byte(`{"data": [{"id": "` + spaceID + `-obj0"}], "pagination": {"total": 1}}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	results, err := client.SearchAll(context.Background(), nil)
+	var spaceErr *SpaceSearchError
+	if !errors.As(err, &spaceErr) || len(spaceErr.Errors) != 1 || spaceErr.Errors["space2"] == nil {
+		t.Fatalf("Expected space2 to be reported as failed, got %v", err)
+	}
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Expected the error of space2 to be wrapped, got %v", err)
+	}
+	if results == nil || len(results.Data) != 1 || results.Data[0].ID != "space1-obj0" {
+		t.Fatalf("Expected the results of space1, got %+v", results)
+	}
+
+	// Without any successful space, the search fails
+	failing["space1"] = true
+	results, err = client.SearchAll(context.Background(), nil)
+	if !errors.As(err, &spaceErr) || len(spaceErr.Errors) != 2 || results != nil {
+		t.Errorf("Expected both spaces to fail without results, got %+v and %v", results, err)
+	}
+}
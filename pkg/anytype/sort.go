@@ -0,0 +1,159 @@
+package anytype
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortProperty is the key of the property search results are sorted by.
+//
+// Any property key can be used. Constants are provided for the properties
+// every object has.
+type SortProperty string
+
+// Sort properties available on every object
+const (
+	SortByName             SortProperty = "name"
+	SortByCreatedDate      SortProperty = "created_date"
+	SortByLastModifiedDate SortProperty = "last_modified_date"
+	SortByLastOpenedDate   SortProperty = "last_opened_date"
+)
+
+// SortDirection is the direction of a sort
+type SortDirection string
+
+// Sort directions
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+// defaultSort is the order of search results when no sort is requested
+var defaultSort = SortOptions{Property: SortByLastModifiedDate, Direction: SortDesc}
+
+// Validate validates a sort option
+func (s SortOptions) Validate() error {
+	if strings.TrimSpace(string(s.Property)) == "" {
+		return fmt.Errorf("sort property is required: %w", ErrInvalidParameter)
+	}
+	switch s.Direction {
+	case "", SortAsc, SortDesc:
+		return nil
+	}
+	return fmt.Errorf("invalid sort direction %q, expected asc or desc: %w", s.Direction, ErrInvalidParameter)
+}
+
+// sortKeys returns the sort options of the search parameters in order of precedence
+func (p *SearchParams) sortKeys() []SortOptions {
+	if p.Sort == nil {
+		return p.Sorts
+	}
+	return append([]SortOptions{*p.Sort}, p.Sorts...)
+}
+
+// sortObjects sorts objects client-side, keeping the relative order of equal objects.
+//
+// Objects missing a sort value are placed last whatever the direction.
+func sortObjects(objects []Object, sorts []SortOptions) {
+	if len(sorts) == 0 {
+		return
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		for _, s := range sorts {
+			if cmp := compareObjects(objects[i], objects[j], s); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+}
+
+// compareObjects compares two objects by a sort option, returning -1, 0 or 1
+func compareObjects(a, b Object, s SortOptions) int {
+	av, aok := sortValue(a, string(s.Property))
+	bv, bok := sortValue(b, string(s.Property))
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return 1
+	case !bok:
+		return -1
+	}
+
+	cmp := 0
+	switch x := av.(type) {
+	case time.Time:
+		if y, ok := bv.(time.Time); ok {
+			cmp = x.Compare(y)
+		}
+	case float64:
+		if y, ok := bv.(float64); ok {
+			switch {
+			case x < y:
+				cmp = -1
+			case x > y:
+				cmp = 1
+			}
+		}
+	case string:
+		if y, ok := bv.(string); ok {
+			cmp = strings.Compare(x, y)
+		}
+	}
+
+	if s.Direction == SortDesc {
+		return -cmp
+	}
+	return cmp
+}
+
+// sortValue returns the comparable value of an object for a sort property
+func sortValue(obj Object, property string) (interface{}, bool) {
+	if property == "name" {
+		return strings.ToLower(obj.Name), obj.Name != ""
+	}
+
+	prop, found := findProperty(obj, property)
+	if !found {
+		return nil, false
+	}
+
+	switch PropertyFormat(prop.Format) {
+	case PropertyFormatDate:
+		return parseFilterDate(prop.Date)
+	case PropertyFormatNumber:
+		return prop.Number, true
+	case PropertyFormatCheckbox:
+		if prop.Checkbox {
+			return 1.0, true
+		}
+		return 0.0, true
+	case PropertyFormatSelect:
+		if prop.Select == nil {
+			return nil, false
+		}
+		return strings.ToLower(prop.Select.Name), true
+	}
+
+	if date, ok := parseFilterDate(prop.Date); ok {
+		return date, true
+	}
+	text := propertyText(prop)
+	return strings.ToLower(text), text != ""
+}
+
+// windowObjects returns the objects between offset and offset+limit
+func windowObjects(objects []Object, offset, limit int) []Object {
+	start := offset
+	if start > len(objects) {
+		start = len(objects)
+	}
+	end := start + limit
+	if end > len(objects) {
+		end = len(objects)
+	}
+	return objects[start:end]
+}
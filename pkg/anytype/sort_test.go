@@ -0,0 +1,126 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestSortObjects tests client-side sorting by several keys
+func TestSortObjects(t *testing.T) {
+	object := func(id, status, date string) Object {
+		obj := Object{ID: id}
+		if status != "" {
+			obj.Properties = append(obj.Properties, Property{Key: "status", Format: "select", Select: &PropertyTag{Name: status}})
+		}
+		if date != "" {
+			obj.Properties = append(obj.Properties, Property{Key: "last_modified_date", Format: "date", Date: date})
+		}
+		return obj
+	}
+
+	objects := []Object{
+		object("a", "Done", "2025-01-01"),
+		object("b", "", "2025-05-01"),
+		object("c", "todo", "2025-01-01"),
+		object("d", "done", "2025-03-01"),
+		object("e", "Todo", ""),
+		object("f", "todo", "2025-01-01"),
+	}
+	sortObjects(objects, []SortOptions{
+		{Property: "status", Direction: SortAsc},
+		{Property: SortByLastModifiedDate, Direction: SortDesc},
+	})
+
+	// Missing values go last in both directions, equal objects keep their order
+	var ids string
+	for _, obj := range objects {
+		ids += obj.ID
+	}
+	if ids != "dacfeb" {
+		t.Errorf("Expected order dacfeb, got %s", ids)
+	}
+}
+
+// TestSortValidation tests validation of sort options
+func TestSortValidation(t *testing.T) {
+	params := &SearchParams{Sorts: []SortOptions{{Property: SortByName, Direction: "up"}}}
+	if err := params.Validate(); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter for an invalid direction, got %v", err)
+	}
+
+	params = &SearchParams{Sort: &SortOptions{Direction: SortAsc}}
+	if err := params.Validate(); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter for a missing property, got %v", err)
+	}
+
+	if err := NewSearchParams().Validate(); err != nil {
+		t.Errorf("Expected default parameters to be valid, got %v", err)
+	}
+}
+
+// TestMultiSortSearch tests that further sort keys are applied client-side over all results
+func TestMultiSortSearch(t *testing.T) {
+	const count = 150
+	statuses := []string{"a", "b", "c"}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body SearchRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode search body: %v", err)
+		}
+		if body.Sort == nil || body.Sort.Property != "status" || body.Sort.Direction != SortAsc {
+			t.Errorf("Expected the server to sort by the first key, got %+v", body.Sort)
+		}
+
+		end := body.Offset + body.Limit
+		if end > count {
+			end = count
+		}
+		objects := make([]Object, 0)
+		for i := body.Offset; i < end; i++ {
+			objects = append(objects, Object{
+				ID: fmt.Sprintf("obj%d", i),
+				Properties: []Property{
+					{Key: "status", Format: "select", Select: &PropertyTag{Name: statuses[i%3]}},
+					{Key: "last_modified_date", Format: "date", Date: base.AddDate(0, 0, i).Format(time.RFC3339)},
+				},
+			})
+		}
+		json.NewEncoder(w).Encode(SearchResponse{
+			Data:       objects,
+			Pagination: Pagination{Total: count, Offset: body.Offset, Limit: body.Limit, HasMore: end < count},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	results, err := client.NewQueryBuilder("space123").
+		WithSort("status", SortAsc).
+		WithSortByUpdatedAt(false).
+		WithOffset(1).
+		WithLimit(3).
+		Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	// Status "a" objects are obj0, obj3, ... obj147, most recent first
+	if len(results.Data) != 3 || results.Data[0].ID != "obj144" || results.Data[1].ID != "obj141" || results.Data[2].ID != "obj138" {
+		t.Fatalf("Unexpected results: %+v", results.Data)
+	}
+	expected := Pagination{Total: count, Offset: 1, Limit: 3, HasMore: true}
+	if results.Pagination != expected {
+		t.Errorf("Expected pagination %+v, got %+v", expected, results.Pagination)
+	}
+}
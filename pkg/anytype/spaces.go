@@ -0,0 +1,183 @@
+package anytype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// spaceMembersConcurrency is the number of spaces whose members are fetched concurrently
+const spaceMembersConcurrency = 4
+
+// GetSpacesWithParams retrieves all available spaces, walking through all result pages.
+//
+// When params.IncludeMembers is set, the members of all spaces are fetched
+// concurrently. A space whose members could not be fetched is still returned,
+// with its MembersErr field set; the other spaces are not affected. A nil
+// params is equivalent to NewGetSpacesParams().
+//
+// Example:
+//
+//	spaces, err := client.GetSpacesWithParams(ctx, &anytype.GetSpacesParams{IncludeMembers: true})
+//	if err != nil {
+//	    log.Fatalf("Failed to get spaces: %v", err)
+//	}
+//
+//	for _, space := range spaces.Data {
+//	    if space.MembersErr != nil {
+//	        log.Printf("Members of %s unavailable: %v", space.Name, space.MembersErr)
+//	        continue
+//	    }
+//	    fmt.Printf("%s: %d members\n", space.Name, len(space.Members))
+//	}
+func (c *Client) GetSpacesWithParams(ctx context.Context, params *GetSpacesParams) (*SpacesResponse, error) {
+	if params == nil {
+		params = NewGetSpacesParams()
+	}
+
+	spaces, err := c.SpacesPager().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IncludeMembers {
+		c.fetchSpaceMembers(ctx, spaces)
+
+		// Members missing because the caller gave up are not a partial failure
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SpacesResponse{
+		Data:       spaces,
+		Pagination: completePagination(len(spaces)),
+	}, nil
+}
+
+// fetchSpaceMembers populates the members of the spaces concurrently,
+// recording the error of each space whose members could not be fetched
+func (c *Client) fetchSpaceMembers(ctx context.Context, spaces []Space) {
+	slots := make(chan struct{}, spaceMembersConcurrency)
+	var wg sync.WaitGroup
+	for i := range spaces {
+		wg.Add(1)
+		go func(space *Space) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				space.MembersErr = ctx.Err()
+				return
+			}
+
+			if c.debug && c.logger != nil {
+				c.logger.Debug("Fetching members for space %s (%s)", space.Name, space.ID)
+			}
+
+			members, err := c.GetMembers(ctx, space.ID)
+			if err != nil {
+				if c.debug && c.logger != nil {
+					c.logger.Debug("Warning: failed to get members for space %s: %v", space.ID, err)
+				}
+				space.MembersErr = fmt.Errorf("failed to get members for space %s: %w", space.ID, err)
+				return
+			}
+			space.Members = members.Data
+		}(&spaces[i])
+	}
+	wg.Wait()
+}
+
+// CreateSpace creates a new space.
+//
+// Example:
+//
+//	space, err := client.CreateSpace(ctx, &anytype.CreateSpaceParams{
+//	    Name:        "Onboarding",
+//	    Description: "Documents for new team members",
+//	    Icon:        &anytype.Icon{Format: "emoji", Emoji: "🚀"},
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to create space: %v", err)
+//	}
+//
+//	fmt.Printf("Created space %s (ID: %s)\n", space.Name, space.ID)
+func (c *Client) CreateSpace(ctx context.Context, params *CreateSpaceParams) (*Space, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces", 0, "invalid space parameters", err)
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError("/v1/spaces", 0, "failed to marshal space", err)
+	}
+
+	ctx = withOperation(ctx, "CreateSpace", "/v1/spaces")
+	data, err := c.makeRequest(ctx, http.MethodPost, "/v1/spaces", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError("/v1/spaces", 0, "failed to create space", err)
+	}
+
+	return c.parseSpaceResponse("/v1/spaces", data)
+}
+
+// UpdateSpace updates the name, description or icon of a space.
+//
+// Only the fields set in params are changed.
+//
+// Example:
+//
+//	name := "Onboarding (archived)"
+//	space, err := client.UpdateSpace(ctx, &anytype.UpdateSpaceParams{
+//	    SpaceID: "space123",
+//	    Name:    &name,
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to update space: %v", err)
+//	}
+func (c *Client) UpdateSpace(ctx context.Context, params *UpdateSpaceParams) (*Space, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}", 0, "invalid space parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s", params.SpaceID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal space", err)
+	}
+
+	ctx = withOperation(ctx, "UpdateSpace", "/v1/spaces/{space_id}")
+	data, err := c.makeRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to update space %s", params.SpaceID), err)
+	}
+
+	return c.parseSpaceResponse(path, data)
+}
+
+// parseSpaceResponse parses a response wrapping a single space
+func (c *Client) parseSpaceResponse(path string, data []byte) (*Space, error) {
+	if c.debug && c.logger != nil {
+		c.logger.Debug("Raw space response: %s", string(data))
+	}
+
+	var response struct {
+		Space Space `json:"space"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, wrapError(path, 0, "failed to parse space response", err)
+	}
+
+	return &response.Space, nil
+}
@@ -0,0 +1,186 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordedRequest is a request received by a server set up with setupRecordingServer
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// setupRecordingServer sets up a mock server with a fixed response that records the requests it receives
+func setupRecordingServer(t *testing.T, response string, requests *[]recordedRequest) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := recordedRequest{Method: r.Method, Path: r.URL.Path}
+		if r.Body != nil && r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&request.Body); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+		}
+		*requests = append(*requests, request)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return server, client
+}
+
+// TestCreateSpace tests creating a space
+func TestCreateSpace(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"space": {"id": "space789", "name": "Onboarding", "home_object_id": "home1"}}`, &requests)
+	defer server.Close()
+
+	space, err := client.CreateSpace(context.Background(), &CreateSpaceParams{
+		Name:        "Onboarding",
+		Description: "New team members",
+		Icon:        &Icon{Format: "emoji", Emoji: "🚀"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSpace failed: %v", err)
+	}
+	if space.ID != "space789" || space.HomeObjectID != "home1" {
+		t.Errorf("Unexpected space: %+v", space)
+	}
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPost || requests[0].Path != "/v1/spaces" {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	body := requests[0].Body
+	icon, _ := body["icon"].(map[string]interface{})
+	if body["name"] != "Onboarding" || body["description"] != "New team members" || icon["emoji"] != "🚀" {
+		t.Errorf("Unexpected request body: %v", body)
+	}
+
+	if _, err := client.CreateSpace(context.Background(), &CreateSpaceParams{Name: " "}); !errors.Is(err, ErrMissingRequired) {
+		t.Errorf("Expected ErrMissingRequired for a missing name, got %v", err)
+	}
+}
+
+// TestUpdateSpace tests that only the fields set are sent when updating a space
+func TestUpdateSpace(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"space": {"id": "space123", "name": "Renamed"}}`, &requests)
+	defer server.Close()
+
+	name := "Renamed"
+	space, err := client.UpdateSpace(context.Background(), &UpdateSpaceParams{SpaceID: "space123", Name: &name})
+	if err != nil {
+		t.Fatalf("UpdateSpace failed: %v", err)
+	}
+	if space.Name != "Renamed" {
+		t.Errorf("Unexpected space: %+v", space)
+	}
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPatch || requests[0].Path != "/v1/spaces/space123" {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	if len(requests[0].Body) != 1 || requests[0].Body["name"] != "Renamed" {
+		t.Errorf("Expected only the name to be sent, got %v", requests[0].Body)
+	}
+
+	// Clearing the description sends an empty string
+	empty := ""
+	if _, err := client.UpdateSpace(context.Background(), &UpdateSpaceParams{SpaceID: "space123", Description: &empty}); err != nil {
+		t.Fatalf("UpdateSpace failed: %v", err)
+	}
+	if description, ok := requests[1].Body["description"]; !ok || description != "" {
+		t.Errorf("Expected an empty description to be sent, got %v", requests[1].Body)
+	}
+
+	invalid := []*UpdateSpaceParams{
+		nil,
+		{Name: &name},
+		{SpaceID: "space123"},
+		{SpaceID: "space123", Name: &empty},
+	}
+	for _, params := range invalid {
+		if _, err := client.UpdateSpace(context.Background(), params); err == nil {
+			t.Errorf("Expected an error for %+v", params)
+		}
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected invalid updates not to be sent, got %d requests", len(requests))
+	}
+}
+
+// TestGetSpacesWithParams tests concurrent member loading and per-space member errors
+func TestGetSpacesWithParams(t *testing.T) {
+	var memberRequests, inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/spaces" {
+			spaces := make([]Space, 0)
+			for i := 0; i < 10; i++ {
+				spaces = append(spaces, Space{ID: fmt.Sprintf("space%d", i)})
+			}
+			json.NewEncoder(w).Encode(SpacesResponse{Data: spaces, Pagination: Pagination{Total: len(spaces)}})
+			return
+		}
+
+		atomic.AddInt32(&memberRequests, 1)
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		if r.URL.Path == "/v1/spaces/space3/members" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"data": [{"id": "member1", "role": "owner"}], "pagination": {"total": 1}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	spaces, err := client.GetSpacesWithParams(context.Background(), &GetSpacesParams{})
+	if err != nil {
+		t.Fatalf("GetSpacesWithParams failed: %v", err)
+	}
+	if len(spaces.Data) != 10 || memberRequests != 0 {
+		t.Fatalf("Expected 10 spaces without member requests, got %d spaces and %d requests", len(spaces.Data), memberRequests)
+	}
+
+	spaces, err = client.GetSpaces(context.Background())
+	if err != nil {
+		t.Fatalf("GetSpaces failed: %v", err)
+	}
+	for _, space := range spaces.Data {
+		if space.ID == "space3" {
+			if space.MembersErr == nil || !errors.Is(space.MembersErr, ErrUnauthorized) {
+				t.Errorf("Expected a member error for space3, got %v", space.MembersErr)
+			}
+			continue
+		}
+		if space.MembersErr != nil || len(space.Members) != 1 || space.Members[0].Role != MemberRoleOwner {
+			t.Errorf("Unexpected members for %s: %+v (%v)", space.ID, space.Members, space.MembersErr)
+		}
+	}
+	if maxInFlight < 2 || maxInFlight > spaceMembersConcurrency {
+		t.Errorf("Expected between 2 and %d concurrent member requests, got %d", spaceMembersConcurrency, maxInFlight)
+	}
+}
@@ -0,0 +1,484 @@
+package anytype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// tagPropertyKey is the key of the property holding the tags of objects
+const tagPropertyKey = "tag"
+
+// TagColor is the color of a tag
+type TagColor string
+
+// Tag colors
+const (
+	TagColorGrey   TagColor = "grey"
+	TagColorYellow TagColor = "yellow"
+	TagColorOrange TagColor = "orange"
+	TagColorRed    TagColor = "red"
+	TagColorPink   TagColor = "pink"
+	TagColorPurple TagColor = "purple"
+	TagColorBlue   TagColor = "blue"
+	TagColorIce    TagColor = "ice"
+	TagColorTeal   TagColor = "teal"
+	TagColorLime   TagColor = "lime"
+)
+
+// IsValid reports whether the color is a known color
+func (c TagColor) IsValid() bool {
+	switch c {
+	case TagColorGrey, TagColorYellow, TagColorOrange, TagColorRed, TagColorPink,
+		TagColorPurple, TagColorBlue, TagColorIce, TagColorTeal, TagColorLime:
+		return true
+	}
+	return false
+}
+
+// WithTagResolution makes CreateObject and UpdateObject refer to the tags in
+// Object.Tags by ID.
+//
+// By default tags are sent by name. With this option, the names are matched
+// case-insensitively against the tags of the "tag" property of the space.
+// Names without a matching tag are created when createMissing is true, and
+// sent by name otherwise. Resolving tags costs two extra requests per write.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithTagResolution(true),
+//	)
+func WithTagResolution(createMissing bool) ClientOption {
+	return func(c *Client) {
+		c.resolveTags = true
+		c.createMissingTags = createMissing
+	}
+}
+
+// TagsResponse represents the tags of a select or multi_select property
+type TagsResponse struct {
+	Data       []PropertyTag `json:"data"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// CreateTagParams represents parameters for creating a tag of a select or
+// multi_select property
+type CreateTagParams struct {
+	SpaceID    string   `json:"-"`               // Space ID the property belongs to
+	PropertyID string   `json:"-"`               // Property ID to add the tag to
+	Key        string   `json:"key,omitempty"`   // Key of the tag, generated if empty
+	Name       string   `json:"name"`            // Name of the tag
+	Color      TagColor `json:"color,omitempty"` // Color of the tag
+}
+
+// Validate validates CreateTagParams fields
+func (p *CreateTagParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.PropertyID == "" {
+		return fmt.Errorf("property ID is required: %w", ErrMissingRequired)
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("tag name is required: %w", ErrMissingRequired)
+	}
+	if p.Color != "" && !p.Color.IsValid() {
+		return fmt.Errorf("invalid tag color %q: %w", p.Color, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// UpdateTagParams represents parameters for renaming or recoloring a tag.
+// Only the fields that are set are updated.
+type UpdateTagParams struct {
+	SpaceID    string    `json:"-"`               // Space ID the property belongs to
+	PropertyID string    `json:"-"`               // Property ID the tag belongs to
+	TagID      string    `json:"-"`               // Tag ID to update
+	Name       *string   `json:"name,omitempty"`  // New name of the tag
+	Color      *TagColor `json:"color,omitempty"` // New color of the tag
+}
+
+// Validate validates UpdateTagParams fields
+func (p *UpdateTagParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.PropertyID == "" || p.TagID == "" {
+		return fmt.Errorf("property ID and tag ID are required: %w", ErrMissingRequired)
+	}
+	if p.Name == nil && p.Color == nil {
+		return fmt.Errorf("nothing to update: %w", ErrMissingRequired)
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		return fmt.Errorf("tag name cannot be empty: %w", ErrInvalidParameter)
+	}
+	if p.Color != nil && !p.Color.IsValid() {
+		return fmt.Errorf("invalid tag color %q: %w", *p.Color, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// GetTags retrieves all tags of a select or multi_select property, walking
+// through all result pages
+func (c *Client) GetTags(ctx context.Context, spaceID, propertyID string) (*TagsResponse, error) {
+	if spaceID == "" {
+		return nil, ErrInvalidSpaceID
+	}
+	if propertyID == "" {
+		return nil, wrapError("/v1/spaces/{id}/properties/{property_id}/tags", 0, "property ID is required", ErrMissingRequired)
+	}
+
+	tags, err := c.TagsPager(spaceID, propertyID).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TagsResponse{Data: tags, Pagination: completePagination(len(tags))}, nil
+}
+
+// getTagsPage retrieves a single page of tags of a property
+func (c *Client) getTagsPage(ctx context.Context, spaceID, propertyID string, offset, limit int) ([]PropertyTag, Pagination, error) {
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s/tags?offset=%d&limit=%d", spaceID, propertyID, offset, limit)
+	ctx = withOperation(ctx, "GetTags", "/v1/spaces/{space_id}/properties/{property_id}/tags")
+	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, Pagination{}, wrapError(path, 0, fmt.Sprintf("failed to get tags of property %s", propertyID), err)
+	}
+
+	var response TagsResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, Pagination{}, wrapError(path, 0, "failed to parse tags response", err)
+	}
+
+	return response.Data, response.Pagination, nil
+}
+
+// CreateTag creates a tag of a select or multi_select property.
+//
+// Example:
+//
+//	tag, err := client.CreateTag(ctx, &anytype.CreateTagParams{
+//	    SpaceID:    "space123",
+//	    PropertyID: "prop456",
+//	    Name:       "SEV1",
+//	    Color:      anytype.TagColorRed,
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to create tag: %v", err)
+//	}
+func (c *Client) CreateTag(ctx context.Context, params *CreateTagParams) (*PropertyTag, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/properties/{property_id}/tags", 0, "invalid tag parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s/tags", params.SpaceID, params.PropertyID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal tag", err)
+	}
+
+	ctx = withOperation(ctx, "CreateTag", "/v1/spaces/{space_id}/properties/{property_id}/tags")
+	data, err := c.makeRequest(ctx, http.MethodPost, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to create tag %s", params.Name), err)
+	}
+
+	return parseTagResponse(path, data)
+}
+
+// UpdateTag renames or recolors a tag.
+//
+// Example:
+//
+//	color := anytype.TagColorPurple
+//	tag, err := client.UpdateTag(ctx, &anytype.UpdateTagParams{
+//	    SpaceID:    "space123",
+//	    PropertyID: "prop456",
+//	    TagID:      "tag789",
+//	    Color:      &color,
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to update tag: %v", err)
+//	}
+func (c *Client) UpdateTag(ctx context.Context, params *UpdateTagParams) (*PropertyTag, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/properties/{property_id}/tags/{tag_id}", 0, "invalid tag parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s/tags/%s", params.SpaceID, params.PropertyID, params.TagID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal tag", err)
+	}
+
+	ctx = withOperation(ctx, "UpdateTag", "/v1/spaces/{space_id}/properties/{property_id}/tags/{tag_id}")
+	data, err := c.makeRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to update tag %s", params.TagID), err)
+	}
+
+	return parseTagResponse(path, data)
+}
+
+// RenameTag changes the name of a tag
+func (c *Client) RenameTag(ctx context.Context, spaceID, propertyID, tagID, name string) (*PropertyTag, error) {
+	return c.UpdateTag(ctx, &UpdateTagParams{SpaceID: spaceID, PropertyID: propertyID, TagID: tagID, Name: &name})
+}
+
+// RecolorTag changes the color of a tag
+func (c *Client) RecolorTag(ctx context.Context, spaceID, propertyID, tagID string, color TagColor) (*PropertyTag, error) {
+	return c.UpdateTag(ctx, &UpdateTagParams{SpaceID: spaceID, PropertyID: propertyID, TagID: tagID, Color: &color})
+}
+
+// DeleteTag deletes a tag of a select or multi_select property.
+//
+// Objects keep their other tags. Use MergeTags to move the objects using the
+// tag to another tag first.
+func (c *Client) DeleteTag(ctx context.Context, spaceID, propertyID, tagID string) error {
+	if spaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if propertyID == "" || tagID == "" {
+		return wrapError("/v1/spaces/{id}/properties/{property_id}/tags/{tag_id}", 0, "property ID and tag ID are required", ErrMissingRequired)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/properties/%s/tags/%s", spaceID, propertyID, tagID)
+	ctx = withOperation(ctx, "DeleteTag", "/v1/spaces/{space_id}/properties/{property_id}/tags/{tag_id}")
+	if _, err := c.makeRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return wrapError(path, 0, fmt.Sprintf("failed to delete tag %s", tagID), err)
+	}
+
+	return nil
+}
+
+// MergeTags moves all objects from one tag to another and deletes the source tag.
+//
+// Every object of the space is scanned for the source tag, which is replaced
+// with the target tag in the property. Objects are patched with the property
+// alone, so their other fields are left untouched. It returns the number of
+// objects updated. If an update fails, the source tag is kept so that the merge can
+// be run again.
+//
+// Example:
+//
+//	// Merge "sev-1" into "SEV1"
+//	updated, err := client.MergeTags(ctx, "space123", "prop456", "tag-sev-1", "tag-SEV1")
+//	if err != nil {
+//	    log.Fatalf("Failed to merge tags: %v", err)
+//	}
+//	fmt.Printf("Retagged %d objects\n", updated)
+func (c *Client) MergeTags(ctx context.Context, spaceID, propertyID, sourceTagID, targetTagID string) (int, error) {
+	if sourceTagID == "" || targetTagID == "" {
+		return 0, fmt.Errorf("source and target tag IDs are required: %w", ErrMissingRequired)
+	}
+	if sourceTagID == targetTagID {
+		return 0, fmt.Errorf("cannot merge a tag into itself: %w", ErrInvalidParameter)
+	}
+
+	property, err := c.GetProperty(ctx, spaceID, propertyID)
+	if err != nil {
+		return 0, err
+	}
+	if !property.Format.HasTags() {
+		return 0, fmt.Errorf("property %s is not a select or multi_select property: %w", property.Key, ErrInvalidParameter)
+	}
+	target, err := c.findTag(ctx, spaceID, propertyID, targetTagID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Collect the changes first, so that updates don't shift the pages being read
+	var changes []PatchObjectParams
+	err = c.SearchPager(spaceID, &SearchParams{}).ForEach(ctx, func(obj Object) error {
+		if retagged, ok := retagObject(obj, *property, sourceTagID, *target); ok {
+			changes = append(changes, PatchObjectParams{SpaceID: spaceID, ObjectID: obj.ID, Properties: []Property{retagged}})
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find objects tagged %s: %w", sourceTagID, err)
+	}
+
+	for i := range changes {
+		if _, err := c.PatchObject(ctx, &changes[i]); err != nil {
+			return i, fmt.Errorf("failed to retag object %s: %w", changes[i].ObjectID, err)
+		}
+	}
+
+	if err := c.DeleteTag(ctx, spaceID, propertyID, sourceTagID); err != nil {
+		return len(changes), err
+	}
+	return len(changes), nil
+}
+
+// findTag returns a tag of a property by ID
+func (c *Client) findTag(ctx context.Context, spaceID, propertyID, tagID string) (*PropertyTag, error) {
+	tags, err := c.GetTags(ctx, spaceID, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tags.Data {
+		if tags.Data[i].ID == tagID {
+			return &tags.Data[i], nil
+		}
+	}
+	return nil, fmt.Errorf("tag %s: %w", tagID, ErrNotFound)
+}
+
+// retagObject returns the property of an object with the source tag replaced
+// by the target tag, and whether the object has the source tag
+func retagObject(obj Object, property PropertyDefinition, sourceTagID string, target PropertyTag) (Property, bool) {
+	for _, prop := range obj.Properties {
+		if prop.Key != property.Key && (prop.Key != "" || prop.Name != property.Name) {
+			continue
+		}
+
+		updated := Property{Key: property.Key, Format: string(property.Format)}
+		found := false
+		if prop.Select != nil && prop.Select.ID == sourceTagID {
+			updated.Select = &target
+			found = true
+		}
+		hasTarget := false
+		for _, tag := range prop.MultiSelect {
+			hasTarget = hasTarget || tag.ID == target.ID
+		}
+		for _, tag := range prop.MultiSelect {
+			if tag.ID != sourceTagID {
+				updated.MultiSelect = append(updated.MultiSelect, tag)
+				continue
+			}
+			found = true
+			if !hasTarget {
+				updated.MultiSelect = append(updated.MultiSelect, target)
+				hasTarget = true
+			}
+		}
+		if found {
+			return updated, true
+		}
+	}
+	return Property{}, false
+}
+
+// ResolveTags returns the tags of a property matching the given names.
+//
+// Names are matched case-insensitively. Missing tags are created when
+// createMissing is true; otherwise they are returned with their name only.
+//
+// Example:
+//
+//	tags, err := client.ResolveTags(ctx, "space123", "prop456", []string{"SEV1", "customer"}, true)
+//	if err != nil {
+//	    log.Fatalf("Failed to resolve tags: %v", err)
+//	}
+func (c *Client) ResolveTags(ctx context.Context, spaceID, propertyID string, names []string, createMissing bool) ([]PropertyTag, error) {
+	existing, err := c.GetTags(ctx, spaceID, propertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]PropertyTag, 0, len(names))
+	for _, name := range names {
+		tag, found := findTagByName(existing.Data, name)
+		if !found && createMissing {
+			created, err := c.CreateTag(ctx, &CreateTagParams{SpaceID: spaceID, PropertyID: propertyID, Name: name})
+			if err != nil {
+				return nil, err
+			}
+			existing.Data = append(existing.Data, *created)
+			tag = *created
+		} else if !found {
+			tag = PropertyTag{Name: name}
+		}
+		resolved = append(resolved, tag)
+	}
+	return resolved, nil
+}
+
+// findTagByName returns the tag with the given name, ignoring case
+func findTagByName(tags []PropertyTag, name string) (PropertyTag, bool) {
+	for _, tag := range tags {
+		if strings.EqualFold(tag.Name, name) {
+			return tag, true
+		}
+	}
+	return PropertyTag{}, false
+}
+
+// setTagRelations turns the names in object.Tags into tag relations,
+// resolved to tag IDs when the client resolves tags
+func (c *Client) setTagRelations(ctx context.Context, spaceID string, object *Object) error {
+	if len(object.Tags) == 0 {
+		return nil
+	}
+
+	tags := make([]PropertyTag, 0, len(object.Tags))
+	for _, name := range object.Tags {
+		tags = append(tags, PropertyTag{Name: name})
+	}
+	if c.resolveTags {
+		propertyID, err := c.tagPropertyID(ctx, spaceID)
+		if err != nil {
+			return err
+		}
+		if tags, err = c.ResolveTags(ctx, spaceID, propertyID, object.Tags, c.createMissingTags); err != nil {
+			return fmt.Errorf("failed to resolve tags: %w", err)
+		}
+	}
+
+	if object.Relations == nil {
+		object.Relations = &Relations{}
+	}
+	if object.Relations.Items == nil {
+		object.Relations.Items = make(map[string][]Relation)
+	}
+
+	tagRelations := make([]Relation, 0, len(tags))
+	for _, tag := range tags {
+		tagRelations = append(tagRelations, Relation{ID: tag.ID, Name: tag.Name})
+	}
+	object.Relations.Items["tags"] = tagRelations
+	return nil
+}
+
+// tagPropertyID returns the ID of the property holding the tags of objects in a space
+func (c *Client) tagPropertyID(ctx context.Context, spaceID string) (string, error) {
+	pager := c.PropertiesPager(spaceID)
+	for pager.HasNext() {
+		properties, err := pager.Next(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to find the tag property: %w", err)
+		}
+		for _, property := range properties {
+			if property.Key == tagPropertyKey {
+				return property.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("tag property of space %s: %w", spaceID, ErrNotFound)
+}
+
+// parseTagResponse parses a response wrapping a single tag
+func parseTagResponse(path string, data []byte) (*PropertyTag, error) {
+	var response struct {
+		Tag PropertyTag `json:"tag"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, wrapError(path, 0, "failed to parse tag response", err)
+	}
+
+	return &response.Tag, nil
+}
@@ -0,0 +1,186 @@
+package anytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestTagManagement tests creating, updating and deleting tags
+func TestTagManagement(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, `{"tag": {"object": "tag", "id": "tag1", "key": "sev1", "name": "SEV1", "color": "red"}}`, &requests)
+	defer server.Close()
+	ctx := context.Background()
+
+	tag, err := client.CreateTag(ctx, &CreateTagParams{SpaceID: "space123", PropertyID: "prop1", Name: "SEV1", Color: TagColorRed})
+	if err != nil {
+		t.Fatalf("CreateTag failed: %v", err)
+	}
+	if tag.ID != "tag1" || tag.Key != "sev1" {
+		t.Errorf("Unexpected tag: %+v", tag)
+	}
+	if _, err := client.RenameTag(ctx, "space123", "prop1", "tag1", "Critical"); err != nil {
+		t.Fatalf("RenameTag failed: %v", err)
+	}
+	if _, err := client.RecolorTag(ctx, "space123", "prop1", "tag1", TagColorPurple); err != nil {
+		t.Fatalf("RecolorTag failed: %v", err)
+	}
+	if err := client.DeleteTag(ctx, "space123", "prop1", "tag1"); err != nil {
+		t.Fatalf("DeleteTag failed: %v", err)
+	}
+
+	expected := []string{
+		"POST /v1/spaces/space123/properties/prop1/tags",
+		"PATCH /v1/spaces/space123/properties/prop1/tags/tag1",
+		"PATCH /v1/spaces/space123/properties/prop1/tags/tag1",
+		"DELETE /v1/spaces/space123/properties/prop1/tags/tag1",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	for i, request := range requests {
+		if request.Method+" "+request.Path != expected[i] {
+			t.Errorf("Expected %s, got %s %s", expected[i], request.Method, request.Path)
+		}
+	}
+	if requests[0].Body["color"] != "red" {
+		t.Errorf("Expected the color to be sent, got %v", requests[0].Body)
+	}
+	if len(requests[1].Body) != 1 || requests[1].Body["name"] != "Critical" {
+		t.Errorf("Expected only the name to be sent, got %v", requests[1].Body)
+	}
+	if len(requests[2].Body) != 1 || requests[2].Body["color"] != "purple" {
+		t.Errorf("Expected only the color to be sent, got %v", requests[2].Body)
+	}
+
+	if _, err := client.CreateTag(ctx, &CreateTagParams{SpaceID: "space123", PropertyID: "prop1", Name: "SEV1", Color: "gold"}); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter for an unknown color, got %v", err)
+	}
+	if _, err := client.UpdateTag(ctx, &UpdateTagParams{SpaceID: "space123", PropertyID: "prop1", TagID: "tag1"}); !errors.Is(err, ErrMissingRequired) {
+		t.Errorf("Expected ErrMissingRequired for an empty update, got %v", err)
+	}
+}
+
+// setupTagServer sets up a space with a "tag" property with the tags "work" and "urgent",
+// recording object writes and created tags
+func setupTagServer(t *testing.T, objects []Object, writes *[]recordedRequest) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/properties"):
+			w.Write([]byte(`{"data": [{"id": "prop-status", "key": "status", "format": "select"}, {"id": "prop-tag", "key": "tag", "name": "Tag", "format": "multi_select"}], "pagination": {"total": 2}}`))
+			return
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/properties/prop-tag"):
+			w.Write([]byte(`{"property": {"id": "prop-tag", "key": "tag", "name": "Tag", "format": "multi_select"}}`))
+			return
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/tags"):
+			w.Write([]byte(`{"data": [{"id": "tag-work", "name": "work"}, {"id": "tag-urgent", "name": "Urgent"}], "pagination": {"total": 2}}`))
+			return
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/search"):
+			json.NewEncoder(w).Encode(SearchResponse{Data: objects, Pagination: Pagination{Total: len(objects)}})
+			return
+		}
+
+		request := recordedRequest{Method: r.Method, Path: r.URL.Path}
+		if r.ContentLength != 0 {
+			json.NewDecoder(r.Body).Decode(&request.Body)
+		}
+		*writes = append(*writes, request)
+		if strings.HasSuffix(r.URL.Path, "/tags") {
+			w.Write([]byte(`{"tag": {"id": "tag-created", "name": "customer"}}`))
+			return
+		}
+		w.Write([]byte(`{"object": {"id": "obj1"}}`))
+	}))
+
+	client, err := NewClient(WithURL(server.URL), WithAppKey("test-app-key"), WithTagResolution(true))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return server, client
+}
+
+// TestTagResolution tests that object writes refer to existing tags by ID and create missing ones
+func TestTagResolution(t *testing.T) {
+	var writes []recordedRequest
+	server, client := setupTagServer(t, nil, &writes)
+	defer server.Close()
+
+	object := &Object{ID: "obj1", Name: "Call", Type: &TypeInfo{Key: "ot-task"}, Tags: []string{"urgent", "customer"}}
+	if _, err := client.CreateObject(context.Background(), "space123", object); err != nil {
+		t.Fatalf("CreateObject failed: %v", err)
+	}
+
+	if len(writes) != 2 || writes[0].Path != "/v1/spaces/space123/properties/prop-tag/tags" || writes[0].Body["name"] != "customer" {
+		t.Fatalf("Expected the missing tag to be created first, got %+v", writes)
+	}
+	relations := writes[1].Body["relations"].(map[string]interface{})["items"].(map[string]interface{})["tags"].([]interface{})
+	ids := []string{}
+	for _, relation := range relations {
+		ids = append(ids, relation.(map[string]interface{})["id"].(string))
+	}
+	if strings.Join(ids, ",") != "tag-urgent,tag-created" {
+		t.Errorf("Expected tags to be sent by ID, got %v", relations)
+	}
+}
+
+// TestMergeTags tests moving objects from one tag to another
+func TestMergeTags(t *testing.T) {
+	objects := []Object{
+		{ID: "obj1", Name: "Call", Icon: &Icon{Emoji: "📞"}, Properties: []Property{
+			{Key: "done", Format: "checkbox", Checkbox: true},
+			{Key: "tag", Format: "multi_select", MultiSelect: []PropertyTag{{ID: "tag-urgent"}, {ID: "tag-old"}}},
+		}},
+		{ID: "obj2", Properties: []Property{{Key: "tag", Format: "multi_select", MultiSelect: []PropertyTag{{ID: "tag-work"}, {ID: "tag-urgent"}}}}},
+		{ID: "obj3", Properties: []Property{{Key: "tag", Format: "multi_select", MultiSelect: []PropertyTag{{ID: "tag-old"}, {ID: "tag-work"}}}}},
+	}
+	var writes []recordedRequest
+	server, client := setupTagServer(t, objects, &writes)
+	defer server.Close()
+
+	updated, err := client.MergeTags(context.Background(), "space123", "prop-tag", "tag-old", "tag-urgent")
+	if err != nil {
+		t.Fatalf("MergeTags failed: %v", err)
+	}
+	if updated != 2 || len(writes) != 3 {
+		t.Fatalf("Expected 2 objects to be retagged, got %d (%+v)", updated, writes)
+	}
+
+	expected := map[string]string{
+		"/v1/spaces/space123/objects/obj1": "tag-urgent",
+		"/v1/spaces/space123/objects/obj3": "tag-urgent,tag-work",
+	}
+	for _, write := range writes[:2] {
+		// Only the tag property is sent, leaving the rest of the object untouched
+		properties, _ := write.Body["properties"].([]interface{})
+		if write.Method != http.MethodPatch || len(write.Body) != 1 || len(properties) != 1 {
+			t.Fatalf("Expected only the tag property to be patched, got %s %v", write.Method, write.Body)
+		}
+		property := properties[0].(map[string]interface{})
+		for field := range property {
+			if field != "key" && field != "format" && field != "multi_select" {
+				t.Errorf("Unexpected field %s sent for %s: %v", field, write.Path, property)
+			}
+		}
+		tags := property["multi_select"].([]interface{})
+		ids := []string{}
+		for _, tag := range tags {
+			ids = append(ids, tag.(map[string]interface{})["id"].(string))
+		}
+		if strings.Join(ids, ",") != expected[write.Path] {
+			t.Errorf("Unexpected tags for %s: %v", write.Path, ids)
+		}
+	}
+	if writes[2].Method != http.MethodDelete || writes[2].Path != "/v1/spaces/space123/properties/prop-tag/tags/tag-old" {
+		t.Errorf("Expected the source tag to be deleted, got %+v", writes[2])
+	}
+
+	if _, err := client.MergeTags(context.Background(), "space123", "prop-tag", "tag-old", "tag-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a missing target, got %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package anytype
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultTypeCacheTTL is how long fetched types are cached by default
+const defaultTypeCacheTTL = 5 * time.Minute
+
+// WithTypeCacheTTL sets how long type information is cached per space.
+//
+// Type names and keys are cached to avoid fetching the types of a space for
+// every lookup. By default entries expire after 5 minutes. A zero or negative
+// TTL keeps entries until they are invalidated with InvalidateTypeCache.
+//
+// Example:
+//
+//	client, err := anytype.NewClient(
+//	    anytype.WithURL(apiURL),
+//	    anytype.WithAppKey(appKey),
+//	    anytype.WithTypeCacheTTL(time.Minute),
+//	)
+func WithTypeCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.typeCache.ttl = ttl
+	}
+}
+
+// InvalidateTypeCache removes the cached types of a space.
+//
+// The next type lookup for the space fetches fresh types from the API.
+// An empty spaceID invalidates the cache of every space.
+func (c *Client) InvalidateTypeCache(spaceID string) {
+	c.typeCache.invalidate(spaceID)
+}
+
+// typeCache caches type names per space and deduplicates concurrent fetches.
+//
+// All methods are safe for concurrent use. Maps returned by the cache are
+// shared and must not be modified.
+type typeCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration              // How long entries stay fresh
+	spaces     map[string]*typeCacheEntry // Cached entries by space ID
+	fetches    map[string]*typeFetch      // In-flight fetches by space ID
+	generation uint64                     // Incremented on every invalidation
+}
+
+// typeCacheEntry holds the cached types of a space
+type typeCacheEntry struct {
+	names     map[string]string // Mapping typeKey -> typeName
+	fetchedAt time.Time         // When the types were fetched
+}
+
+// typeFetch represents an in-flight fetch of the types of a space
+type typeFetch struct {
+	done     chan struct{}     // Closed once the fetch completed
+	names    map[string]string // Fetched mapping typeKey -> typeName
+	err      error             // Error returned by the fetch
+	canceled bool              // Whether the context of the fetching caller was done
+}
+
+// newTypeCache creates an empty type cache
+func newTypeCache() *typeCache {
+	return &typeCache{
+		ttl:     defaultTypeCacheTTL,
+		spaces:  make(map[string]*typeCacheEntry),
+		fetches: make(map[string]*typeFetch),
+	}
+}
+
+// get returns the cached type names of a space if they are still fresh
+func (tc *typeCache) get(spaceID string) (map[string]string, bool) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	entry, ok := tc.spaces[spaceID]
+	if !ok {
+		return nil, false
+	}
+	if tc.ttl > 0 && time.Since(entry.fetchedAt) > tc.ttl {
+		delete(tc.spaces, spaceID)
+		return nil, false
+	}
+	return entry.names, true
+}
+
+// store replaces the cached types of a space
+func (tc *typeCache) store(spaceID string, types []TypeInfo) map[string]string {
+	names := typeNamesByKey(types)
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.spaces[spaceID] = &typeCacheEntry{names: names, fetchedAt: time.Now()}
+	return names
+}
+
+// invalidate removes the cached types of a space, or of every space if spaceID is empty
+func (tc *typeCache) invalidate(spaceID string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	if spaceID == "" {
+		tc.spaces = make(map[string]*typeCacheEntry)
+		return
+	}
+	delete(tc.spaces, spaceID)
+}
+
+// fetch loads the types of a space using fetchFn and caches them.
+//
+// Concurrent fetches for the same space share a single call to fetchFn.
+// Callers waiting for another goroutine's fetch stop waiting when their
+// own context is done. If the fetch failed because the context of the
+// fetching caller was done, waiters fetch again with their own context.
+func (tc *typeCache) fetch(ctx context.Context, spaceID string,
+	fetchFn func(ctx context.Context, spaceID string) ([]TypeInfo, error)) (map[string]string, error) {
+
+	for {
+		tc.mu.Lock()
+		inFlight, ok := tc.fetches[spaceID]
+		if !ok {
+			break
+		}
+		tc.mu.Unlock()
+
+		select {
+		case <-inFlight.done:
+			if !inFlight.canceled {
+				return inFlight.names, inFlight.err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	call := &typeFetch{done: make(chan struct{})}
+	tc.fetches[spaceID] = call
+	generation := tc.generation
+	tc.mu.Unlock()
+
+	types, err := fetchFn(ctx, spaceID)
+	if err == nil {
+		call.names = typeNamesByKey(types)
+	}
+	call.err = err
+	call.canceled = err != nil && ctx.Err() != nil
+
+	tc.mu.Lock()
+	delete(tc.fetches, spaceID)
+	// Don't cache results that were invalidated while being fetched
+	if err == nil && generation == tc.generation {
+		tc.spaces[spaceID] = &typeCacheEntry{names: call.names, fetchedAt: time.Now()}
+	}
+	tc.mu.Unlock()
+
+	close(call.done)
+	return call.names, call.err
+}
+
+// typeNamesByKey builds a mapping typeKey -> typeName
+func typeNamesByKey(types []TypeInfo) map[string]string {
+	names := make(map[string]string, len(types))
+	for _, t := range types {
+		names[t.Key] = t.Name
+	}
+	return names
+}
+
+// cachedTypeNames returns the type names of a space, fetching them if they are not cached.
+//
+// The returned boolean reports whether the names were fetched by this call.
+func (c *Client) cachedTypeNames(ctx context.Context, spaceID string) (map[string]string, bool, error) {
+	if names, ok := c.typeCache.get(spaceID); ok {
+		return names, false, nil
+	}
+
+	names, err := c.typeCache.fetch(ctx, spaceID, c.fetchTypes)
+	return names, true, err
+}
@@ -0,0 +1,162 @@
+package anytype
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupTypesServer creates a test server serving the types of a space and counting requests
+func setupTypesServer(t *testing.T, calls *int32, opts ...ClientOption) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		// Keep the request in flight long enough for concurrent lookups to pile up
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"data": [
+				{"key": "ot-note", "name": "Note"},
+				{"key": "ot-task", "name": "Task"}
+			]
+		}`))
+	}))
+
+	opts = append([]ClientOption{WithURL(server.URL), WithAppKey("test-app-key")}, opts...)
+	client, err := NewClient(opts...)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return server, client
+}
+
+// TestTypeCacheSingleFlight tests that concurrent lookups of the same space trigger a single request
+func TestTypeCacheSingleFlight(t *testing.T) {
+	var calls int32
+	server, client := setupTypesServer(t, &calls)
+	defer server.Close()
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				if name := client.GetTypeName(ctx, "space123", "ot-task"); name != "Task" {
+					t.Errorf("Expected type name 'Task', got %q", name)
+				}
+				return
+			}
+			key, err := client.GetTypeByName(ctx, "space123", "Note")
+			if err != nil || key != "ot-note" {
+				t.Errorf("Expected type key 'ot-note', got %q (%v)", key, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("Expected 1 types request, got %d", got)
+	}
+}
+
+// TestInvalidateTypeCache tests that invalidating the cache triggers a new fetch
+func TestInvalidateTypeCache(t *testing.T) {
+	var calls int32
+	server, client := setupTypesServer(t, &calls)
+	defer server.Close()
+
+	ctx := context.Background()
+	client.GetTypeName(ctx, "space123", "ot-note")
+	client.GetTypeName(ctx, "space123", "ot-note")
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("Expected 1 types request before invalidation, got %d", got)
+	}
+
+	client.InvalidateTypeCache("space123")
+	client.GetTypeName(ctx, "space123", "ot-note")
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("Expected 2 types requests after invalidation, got %d", got)
+	}
+}
+
+// TestTypeCacheTTL tests that cached types expire after the configured TTL
+func TestTypeCacheTTL(t *testing.T) {
+	var calls int32
+	server, client := setupTypesServer(t, &calls, WithTypeCacheTTL(time.Millisecond))
+	defer server.Close()
+
+	ctx := context.Background()
+	client.GetTypeName(ctx, "space123", "ot-note")
+	time.Sleep(5 * time.Millisecond)
+	client.GetTypeName(ctx, "space123", "ot-note")
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("Expected 2 types requests after expiry, got %d", got)
+	}
+}
+
+// TestTypeCacheCanceledFetch tests that waiters don't receive the context error of the fetching caller
+func TestTypeCacheCanceledFetch(t *testing.T) {
+	tc := newTypeCache()
+	started := make(chan struct{})
+	var calls int32
+	fetchFn := func(ctx context.Context, spaceID string) ([]TypeInfo, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return []TypeInfo{{Key: "ot-task", Name: "Task"}}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := tc.fetch(ctx, "space123", fetchFn)
+		leaderErr <- err
+	}()
+	<-started
+
+	waiterNames := make(chan map[string]string, 1)
+	go func() {
+		names, err := tc.fetch(context.Background(), "space123", fetchFn)
+		if err != nil {
+			t.Errorf("Expected the waiter to fetch again, got %v", err)
+		}
+		waiterNames <- names
+	}()
+	// Let the waiter start waiting for the first fetch
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-leaderErr; err != context.Canceled {
+		t.Errorf("Expected the canceled caller to get context.Canceled, got %v", err)
+	}
+	if names := <-waiterNames; names["ot-task"] != "Task" {
+		t.Errorf("Unexpected names: %v", names)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Expected 2 fetches, got %d", got)
+	}
+
+	// A waiter whose own context is done stops waiting
+	block := make(chan struct{})
+	go tc.fetch(context.Background(), "space456", func(ctx context.Context, spaceID string) ([]TypeInfo, error) {
+		<-block
+		return nil, nil
+	})
+	time.Sleep(20 * time.Millisecond)
+	waiterCtx, waiterCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waiterCancel()
+	if _, err := tc.fetch(waiterCtx, "space456", fetchFn); err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	close(block)
+}
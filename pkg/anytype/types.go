@@ -0,0 +1,271 @@
+package anytype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TypeLayout is the layout of the objects of a type
+type TypeLayout string
+
+// Type layouts
+const (
+	TypeLayoutBasic   TypeLayout = "basic"
+	TypeLayoutProfile TypeLayout = "profile"
+	TypeLayoutAction  TypeLayout = "action"
+	TypeLayoutNote    TypeLayout = "note"
+)
+
+// IsValid reports whether the layout is a known layout
+func (l TypeLayout) IsValid() bool {
+	switch l {
+	case TypeLayoutBasic, TypeLayoutProfile, TypeLayoutAction, TypeLayoutNote:
+		return true
+	}
+	return false
+}
+
+// CreateTypeParams represents parameters for creating a type in a space.
+//
+// Properties lists the properties of objects of the type. Existing properties
+// are referenced by key; properties that don't exist yet are created with the
+// given name and format.
+type CreateTypeParams struct {
+	SpaceID    string               `json:"-"`                     // Space ID to create the type in
+	Key        string               `json:"key,omitempty"`         // Key of the type, generated from the name if empty
+	Name       string               `json:"name"`                  // Display name of the type
+	PluralName string               `json:"plural_name,omitempty"` // Plural display name of the type
+	Layout     TypeLayout           `json:"layout"`                // Layout of objects of the type, basic if empty
+	Icon       *Icon                `json:"icon,omitempty"`        // Type icon
+	Properties []PropertyDefinition `json:"properties,omitempty"`  // Properties of objects of the type
+}
+
+// Validate validates CreateTypeParams fields
+func (p *CreateTypeParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("type name is required: %w", ErrMissingRequired)
+	}
+	if p.Layout != "" && !p.Layout.IsValid() {
+		return fmt.Errorf("invalid type layout %q, expected basic, profile, action or note: %w", p.Layout, ErrInvalidParameter)
+	}
+	return validateTypeProperties(p.Properties)
+}
+
+// UpdateTypeParams represents parameters for updating a type.
+// Only the fields that are set are updated; a non-empty Properties list
+// replaces the properties of the type.
+type UpdateTypeParams struct {
+	SpaceID    string               `json:"-"`                     // Space ID the type belongs to
+	TypeID     string               `json:"-"`                     // Type ID to update
+	Key        *string              `json:"key,omitempty"`         // New key of the type
+	Name       *string              `json:"name,omitempty"`        // New display name of the type
+	PluralName *string              `json:"plural_name,omitempty"` // New plural display name of the type
+	Layout     *TypeLayout          `json:"layout,omitempty"`      // New layout of objects of the type
+	Icon       *Icon                `json:"icon,omitempty"`        // New type icon
+	Properties []PropertyDefinition `json:"properties,omitempty"`  // New properties of objects of the type
+}
+
+// Validate validates UpdateTypeParams fields
+func (p *UpdateTypeParams) Validate() error {
+	if p.SpaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if p.TypeID == "" {
+		return ErrInvalidTypeID
+	}
+	if p.Key == nil && p.Name == nil && p.PluralName == nil && p.Layout == nil && p.Icon == nil && len(p.Properties) == 0 {
+		return fmt.Errorf("nothing to update: %w", ErrMissingRequired)
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		return fmt.Errorf("type name cannot be empty: %w", ErrInvalidParameter)
+	}
+	if p.Layout != nil && !p.Layout.IsValid() {
+		return fmt.Errorf("invalid type layout %q, expected basic, profile, action or note: %w", *p.Layout, ErrInvalidParameter)
+	}
+	return validateTypeProperties(p.Properties)
+}
+
+// validateTypeProperties checks that each property of a type can be resolved or created
+func validateTypeProperties(properties []PropertyDefinition) error {
+	for i, property := range properties {
+		if property.Key == "" && property.Name == "" {
+			return fmt.Errorf("property %d needs a key or a name: %w", i, ErrMissingRequired)
+		}
+		if property.Format == "" {
+			return fmt.Errorf("property %q needs a format: %w", property.Key+property.Name, ErrMissingRequired)
+		}
+		if !property.Format.IsValid() {
+			return fmt.Errorf("property %q has an invalid format %q: %w", property.Key+property.Name, property.Format, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// GetType retrieves a type by ID, including its property definitions.
+//
+// Example:
+//
+//	typeInfo, err := client.GetType(ctx, "space123", "type456")
+//	if err != nil {
+//	    log.Fatalf("Failed to get type: %v", err)
+//	}
+//
+//	fmt.Printf("%s (%s layout)\n", typeInfo.Name, typeInfo.Layout)
+//	for _, property := range typeInfo.Properties {
+//	    fmt.Printf("- %s: %s\n", property.Key, property.Format)
+//	}
+func (c *Client) GetType(ctx context.Context, spaceID, typeID string) (*TypeInfo, error) {
+	if spaceID == "" {
+		return nil, ErrInvalidSpaceID
+	}
+	if typeID == "" {
+		return nil, ErrInvalidTypeID
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/types/%s", spaceID, typeID)
+	ctx = withOperation(ctx, "GetType", "/v1/spaces/{space_id}/types/{type_id}")
+	data, err := c.makeRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to get type %s", typeID), err)
+	}
+
+	return parseTypeResponse(path, data)
+}
+
+// CreateType creates a type in a space.
+//
+// The cached types of the space are invalidated, so that the new type can be
+// resolved by name right away.
+//
+// Example:
+//
+//	incident, err := client.CreateType(ctx, &anytype.CreateTypeParams{
+//	    SpaceID:    "space123",
+//	    Key:        "incident",
+//	    Name:       "Incident",
+//	    PluralName: "Incidents",
+//	    Layout:     anytype.TypeLayoutBasic,
+//	    Icon:       &anytype.Icon{Format: "emoji", Emoji: "🚨"},
+//	    Properties: []anytype.PropertyDefinition{
+//	        {Key: "severity", Name: "Severity", Format: anytype.PropertyFormatSelect},
+//	        {Key: "resolved_at", Name: "Resolved at", Format: anytype.PropertyFormatDate},
+//	    },
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to create type: %v", err)
+//	}
+func (c *Client) CreateType(ctx context.Context, params *CreateTypeParams) (*TypeInfo, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/types", 0, "invalid type parameters", err)
+	}
+
+	request := *params
+	if request.Layout == "" {
+		request.Layout = TypeLayoutBasic
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/types", params.SpaceID)
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal type", err)
+	}
+
+	ctx = withOperation(ctx, "CreateType", "/v1/spaces/{space_id}/types")
+	data, err := c.makeRequest(ctx, http.MethodPost, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to create type %s", params.Name), err)
+	}
+	c.typeCache.invalidate(params.SpaceID)
+
+	return parseTypeResponse(path, data)
+}
+
+// UpdateType updates a type.
+//
+// Only the fields set in params are changed. The cached types of the space
+// are invalidated.
+//
+// Example:
+//
+//	name := "Postmortem"
+//	updated, err := client.UpdateType(ctx, &anytype.UpdateTypeParams{
+//	    SpaceID: "space123",
+//	    TypeID:  "type456",
+//	    Name:    &name,
+//	})
+//	if err != nil {
+//	    log.Fatalf("Failed to update type: %v", err)
+//	}
+func (c *Client) UpdateType(ctx context.Context, params *UpdateTypeParams) (*TypeInfo, error) {
+	if params == nil {
+		return nil, ErrInvalidParameter
+	}
+	if err := params.Validate(); err != nil {
+		return nil, wrapError("/v1/spaces/{id}/types/{type_id}", 0, "invalid type parameters", err)
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/types/%s", params.SpaceID, params.TypeID)
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, wrapError(path, 0, "failed to marshal type", err)
+	}
+
+	ctx = withOperation(ctx, "UpdateType", "/v1/spaces/{space_id}/types/{type_id}")
+	data, err := c.makeRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, wrapError(path, 0, fmt.Sprintf("failed to update type %s", params.TypeID), err)
+	}
+	c.typeCache.invalidate(params.SpaceID)
+
+	return parseTypeResponse(path, data)
+}
+
+// DeleteType archives a type.
+//
+// Archived types are no longer offered for new objects, but existing objects
+// of the type are kept. The cached types of the space are invalidated.
+//
+// Example:
+//
+//	if err := client.DeleteType(ctx, "space123", "type456"); err != nil {
+//	    log.Fatalf("Failed to delete type: %v", err)
+//	}
+func (c *Client) DeleteType(ctx context.Context, spaceID, typeID string) error {
+	if spaceID == "" {
+		return ErrInvalidSpaceID
+	}
+	if typeID == "" {
+		return ErrInvalidTypeID
+	}
+
+	path := fmt.Sprintf("/v1/spaces/%s/types/%s", spaceID, typeID)
+	ctx = withOperation(ctx, "DeleteType", "/v1/spaces/{space_id}/types/{type_id}")
+	if _, err := c.makeRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return wrapError(path, 0, fmt.Sprintf("failed to delete type %s", typeID), err)
+	}
+	c.typeCache.invalidate(spaceID)
+
+	return nil
+}
+
+// parseTypeResponse parses a response wrapping a single type
+func parseTypeResponse(path string, data []byte) (*TypeInfo, error) {
+	var response struct {
+		Type TypeInfo `json:"type"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, wrapError(path, 0, "failed to parse type response", err)
+	}
+
+	return &response.Type, nil
+}
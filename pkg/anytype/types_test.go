@@ -0,0 +1,127 @@
+package anytype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+const testTypeResponse = `{"type": {
+	"object": "type", "id": "type789", "key": "incident", "name": "Incident", "plural_name": "Incidents",
+	"layout": "basic", "recommended_layout": "basic",
+	"properties": [{"object": "property", "id": "prop1", "key": "severity", "name": "Severity", "format": "select"}]
+}}`
+
+// TestGetType tests retrieving a type with its property definitions
+func TestGetType(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, testTypeResponse, &requests)
+	defer server.Close()
+
+	typeInfo, err := client.GetType(context.Background(), "space123", "type789")
+	if err != nil {
+		t.Fatalf("GetType failed: %v", err)
+	}
+	if typeInfo.Key != "incident" || typeInfo.PluralName != "Incidents" || typeInfo.Layout != TypeLayoutBasic {
+		t.Errorf("Unexpected type: %+v", typeInfo)
+	}
+	if len(typeInfo.Properties) != 1 || typeInfo.Properties[0].Key != "severity" || typeInfo.Properties[0].Format != "select" {
+		t.Errorf("Unexpected properties: %+v", typeInfo.Properties)
+	}
+	if len(requests) != 1 || requests[0].Method != http.MethodGet || requests[0].Path != "/v1/spaces/space123/types/type789" {
+		t.Errorf("Unexpected requests: %+v", requests)
+	}
+
+	if _, err := client.GetType(context.Background(), "space123", ""); !errors.Is(err, ErrInvalidTypeID) {
+		t.Errorf("Expected ErrInvalidTypeID, got %v", err)
+	}
+}
+
+// TestCreateType tests creating a type and invalidating the type cache
+func TestCreateType(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, testTypeResponse, &requests)
+	defer server.Close()
+
+	client.typeCache.store("space123", []TypeInfo{{Key: "ot-page", Name: "Page"}})
+
+	typeInfo, err := client.CreateType(context.Background(), &CreateTypeParams{
+		SpaceID:    "space123",
+		Key:        "incident",
+		Name:       "Incident",
+		Properties: []PropertyDefinition{{Key: "severity", Name: "Severity", Format: "select"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateType failed: %v", err)
+	}
+	if typeInfo.ID != "type789" {
+		t.Errorf("Unexpected type: %+v", typeInfo)
+	}
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPost || requests[0].Path != "/v1/spaces/space123/types" {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	body := requests[0].Body
+	properties, _ := body["properties"].([]interface{})
+	if body["name"] != "Incident" || body["key"] != "incident" || body["layout"] != "basic" || len(properties) != 1 {
+		t.Errorf("Unexpected request body: %v", body)
+	}
+	if _, ok := client.typeCache.get("space123"); ok {
+		t.Error("Expected the type cache of the space to be invalidated")
+	}
+
+	invalid := []*CreateTypeParams{
+		nil,
+		{Name: "Incident"},
+		{SpaceID: "space123"},
+		{SpaceID: "space123", Name: "Incident", Layout: "grid"},
+		{SpaceID: "space123", Name: "Incident", Properties: []PropertyDefinition{{Key: "severity"}}},
+	}
+	for _, params := range invalid {
+		if _, err := client.CreateType(context.Background(), params); err == nil {
+			t.Errorf("Expected an error for %+v", params)
+		}
+	}
+	if len(requests) != 1 {
+		t.Errorf("Expected invalid types not to be sent, got %d requests", len(requests))
+	}
+}
+
+// TestUpdateAndDeleteType tests updating and archiving a type
+func TestUpdateAndDeleteType(t *testing.T) {
+	var requests []recordedRequest
+	server, client := setupRecordingServer(t, testTypeResponse, &requests)
+	defer server.Close()
+
+	client.typeCache.store("space123", []TypeInfo{{Key: "incident", Name: "Incident"}})
+
+	layout := TypeLayoutNote
+	if _, err := client.UpdateType(context.Background(), &UpdateTypeParams{SpaceID: "space123", TypeID: "type789", Layout: &layout}); err != nil {
+		t.Fatalf("UpdateType failed: %v", err)
+	}
+	if requests[0].Method != http.MethodPatch || requests[0].Path != "/v1/spaces/space123/types/type789" {
+		t.Errorf("Unexpected request: %+v", requests[0])
+	}
+	if len(requests[0].Body) != 1 || requests[0].Body["layout"] != "note" {
+		t.Errorf("Expected only the layout to be sent, got %v", requests[0].Body)
+	}
+	if _, ok := client.typeCache.get("space123"); ok {
+		t.Error("Expected the type cache to be invalidated after an update")
+	}
+
+	if _, err := client.UpdateType(context.Background(), &UpdateTypeParams{SpaceID: "space123", TypeID: "type789"}); !errors.Is(err, ErrMissingRequired) {
+		t.Errorf("Expected ErrMissingRequired for an empty update, got %v", err)
+	}
+
+	client.typeCache.store("space123", []TypeInfo{{Key: "incident", Name: "Incident"}})
+	if err := client.DeleteType(context.Background(), "space123", "type789"); err != nil {
+		t.Fatalf("DeleteType failed: %v", err)
+	}
+	if len(requests) != 2 || requests[1].Method != http.MethodDelete || requests[1].Path != "/v1/spaces/space123/types/type789" {
+		t.Errorf("Unexpected requests: %+v", requests)
+	}
+	if _, ok := client.typeCache.get("space123"); ok {
+		t.Error("Expected the type cache to be invalidated after a deletion")
+	}
+}
@@ -23,8 +23,9 @@ var (
 	ErrInvalidConfig  = errors.New("invalid configuration")
 )
 
-// getConfigFilePath returns the path to the config file
-func getConfigFilePath() (string, error) {
+// ConfigDir returns the directory holding the anytype-go configuration files,
+// ~/.config/anytype-go, creating it if needed
+func ConfigDir() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return "", fmt.Errorf("could not get user home directory: %w", err)
@@ -38,6 +39,16 @@ func getConfigFilePath() (string, error) {
 		return "", fmt.Errorf("could not create config directory: %w", err)
 	}
 
+	return configDir, nil
+}
+
+// getConfigFilePath returns the path to the config file
+func getConfigFilePath() (string, error) {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+
 	return filepath.Join(configDir, configFileName), nil
 }
 
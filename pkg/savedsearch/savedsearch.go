@@ -0,0 +1,207 @@
+// Package savedsearch stores named search queries on disk so that they can be
+// reused across tools and sessions.
+//
+// Saved searches are kept in a single file, by default
+// ~/.config/anytype-go/saved_searches.json. Files with a .yaml or .yml
+// extension are read and written as YAML, with the same field names.
+package savedsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/epheo/anytype-go/internal/yamljson"
+	"github.com/epheo/anytype-go/pkg/anytype"
+	"github.com/epheo/anytype-go/pkg/auth"
+)
+
+// Storage constants
+const (
+	storeFileName     = "saved_searches.json"
+	storeYAMLFileName = "saved_searches.yaml"
+	storeFileMode     = 0600
+)
+
+var (
+	ErrNotFound    = errors.New("saved search not found")
+	ErrInvalidName = errors.New("invalid saved search name")
+)
+
+// SavedSearch is a named query
+type SavedSearch struct {
+	Name      string                  `json:"name"`                 // Unique name of the search
+	Space     string                  `json:"space,omitempty"`      // Name of the space to search, the default space if empty
+	AllSpaces bool                    `json:"all_spaces,omitempty"` // Whether to search across all spaces
+	Query     anytype.QueryDefinition `json:"query"`                // Query to run
+}
+
+// Validate validates SavedSearch fields
+func (s *SavedSearch) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return ErrInvalidName
+	}
+	return s.Query.Validate()
+}
+
+// Store reads and writes saved searches in a JSON or YAML file
+type Store struct {
+	path string
+}
+
+// NewStore returns a store backed by the file at path.
+// The file is created on the first save, as YAML if path ends with .yaml
+// or .yml and as JSON otherwise.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// DefaultStore returns the store in the anytype-go configuration directory.
+// It uses saved_searches.yaml when that file exists, saved_searches.json otherwise.
+func DefaultStore() (*Store, error) {
+	configDir, err := auth.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	yamlPath := filepath.Join(configDir, storeYAMLFileName)
+	if _, err := os.Stat(yamlPath); err == nil {
+		return NewStore(yamlPath), nil
+	}
+	return NewStore(filepath.Join(configDir, storeFileName)), nil
+}
+
+// Path returns the path of the file backing the store
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns all saved searches sorted by name
+func (s *Store) List() ([]SavedSearch, error) {
+	searches, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(searches, func(i, j int) bool {
+		return searches[i].Name < searches[j].Name
+	})
+	return searches, nil
+}
+
+// Get returns the saved search with the given name
+func (s *Store) Get(name string) (*SavedSearch, error) {
+	searches, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for i := range searches {
+		if searches[i].Name == name {
+			return &searches[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Save stores a search, replacing any saved search with the same name
+func (s *Store) Save(search SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	if err := search.Validate(); err != nil {
+		return err
+	}
+
+	searches, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i := range searches {
+		if searches[i].Name == search.Name {
+			searches[i] = search
+			replaced = true
+		}
+	}
+	if !replaced {
+		searches = append(searches, search)
+	}
+	return s.write(searches)
+}
+
+// Delete removes the saved search with the given name
+func (s *Store) Delete(name string) error {
+	searches, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	kept := searches[:0]
+	for _, search := range searches {
+		if search.Name != name {
+			kept = append(kept, search)
+		}
+	}
+	if len(kept) == len(searches) {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return s.write(kept)
+}
+
+// load reads all saved searches, returning none if the file doesn't exist
+func (s *Store) load() ([]SavedSearch, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []SavedSearch{}, nil
+		}
+		return nil, fmt.Errorf("error reading saved searches: %w", err)
+	}
+
+	if s.isYAML() {
+		if data, err = yamljson.ToJSON(data); err != nil {
+			return nil, fmt.Errorf("error parsing saved searches: %w", err)
+		}
+	}
+
+	searches := []SavedSearch{}
+	if err := json.Unmarshal(data, &searches); err != nil {
+		return nil, fmt.Errorf("error parsing saved searches: %w", err)
+	}
+	if searches == nil {
+		// Empty YAML file
+		searches = []SavedSearch{}
+	}
+	return searches, nil
+}
+
+// write replaces the file with the given searches
+func (s *Store) write(searches []SavedSearch) error {
+	data, err := json.MarshalIndent(searches, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling saved searches: %w", err)
+	}
+	if s.isYAML() {
+		if data, err = yamljson.FromJSON(data); err != nil {
+			return fmt.Errorf("error marshaling saved searches: %w", err)
+		}
+	}
+
+	// Write to a temporary file first so that a failed write keeps the previous searches
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, storeFileMode); err != nil {
+		return fmt.Errorf("error writing saved searches: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("error writing saved searches: %w", err)
+	}
+	return nil
+}
+
+// isYAML reports whether the file of the store is a YAML file
+func (s *Store) isYAML() bool {
+	ext := strings.ToLower(filepath.Ext(s.path))
+	return ext == ".yaml" || ext == ".yml"
+}
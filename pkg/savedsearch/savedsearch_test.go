@@ -0,0 +1,108 @@
+package savedsearch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/epheo/anytype-go/pkg/anytype"
+)
+
+// TestStore tests saving, listing and deleting searches in JSON and YAML files
+func TestStore(t *testing.T) {
+	for _, name := range []string{"saved_searches.json", "saved_searches.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			testStore(t, filepath.Join(t.TempDir(), name))
+		})
+	}
+}
+
+// testStore tests saving, listing and deleting searches in the file at path
+func testStore(t *testing.T, path string) {
+	store := NewStore(path)
+
+	searches, err := store.List()
+	if err != nil || len(searches) != 0 {
+		t.Fatalf("Expected no saved searches, got %v (%v)", searches, err)
+	}
+
+	urgent := SavedSearch{
+		Name:  "urgent",
+		Space: "Work",
+		Query: anytype.QueryDefinition{
+			Types:   []string{"Task"},
+			AllTags: []string{"urgent"},
+			Filters: &anytype.FilterExpression{
+				Operator: anytype.FilterOperatorAnd,
+				Filters:  []anytype.FilterExpression{anytype.NumberProperty("priority").GreaterThan(2)},
+			},
+			Sorts: []anytype.SortOptions{{Property: anytype.SortByName, Direction: anytype.SortAsc}},
+		},
+	}
+	for _, search := range []SavedSearch{urgent, {Name: "all", AllSpaces: true}} {
+		if err := store.Save(search); err != nil {
+			t.Fatalf("Failed to save %s: %v", search.Name, err)
+		}
+	}
+
+	// Saving under an existing name replaces the search
+	urgent.Query.Limit = 10
+	if err := store.Save(urgent); err != nil {
+		t.Fatalf("Failed to replace search: %v", err)
+	}
+
+	searches, err = store.List()
+	if err != nil {
+		t.Fatalf("Failed to list searches: %v", err)
+	}
+	if len(searches) != 2 || searches[0].Name != "all" || searches[1].Name != "urgent" {
+		t.Fatalf("Unexpected searches: %+v", searches)
+	}
+
+	got, err := NewStore(path).Get("urgent")
+	if err != nil {
+		t.Fatalf("Failed to get search: %v", err)
+	}
+	if !reflect.DeepEqual(*got, urgent) {
+		t.Errorf("Unexpected search:\n%+v\nexpected:\n%+v", *got, urgent)
+	}
+
+	// The file is written in the format of its extension
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read store: %v", err)
+	}
+	if yamlFile := filepath.Ext(path) == ".yaml"; yamlFile != strings.Contains(string(data), "- name: all") {
+		t.Errorf("Unexpected file content for %s:\n%s", path, data)
+	}
+
+	if err := store.Delete("all"); err != nil {
+		t.Fatalf("Failed to delete search: %v", err)
+	}
+	if _, err := store.Get("all"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+	if err := store.Delete("all"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound when deleting twice, got %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != storeFileMode {
+		t.Errorf("Expected file mode %o, got %v (%v)", storeFileMode, info, err)
+	}
+}
+
+// TestStoreValidation tests that invalid searches are rejected
+func TestStoreValidation(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "saved_searches.json"))
+
+	if err := store.Save(SavedSearch{Name: " "}); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("Expected ErrInvalidName, got %v", err)
+	}
+	if err := store.Save(SavedSearch{Name: "bad", Query: anytype.QueryDefinition{Offset: -1}}); err == nil {
+		t.Error("Expected an error for an invalid query")
+	}
+}
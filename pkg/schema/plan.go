@@ -0,0 +1,380 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/epheo/anytype-go/pkg/anytype"
+)
+
+// ActionKind is the kind of change made by an action
+type ActionKind string
+
+// Action kinds
+const (
+	ActionCreateType ActionKind = "create_type"
+	ActionUpdateType ActionKind = "update_type"
+	ActionCreateTag  ActionKind = "create_tag"
+	ActionUpdateTag  ActionKind = "update_tag"
+)
+
+// Action is a single change needed to make a space match a manifest
+type Action struct {
+	Kind     ActionKind `json:"kind"`               // Kind of change
+	Type     string     `json:"type,omitempty"`     // Key of the type created or updated
+	Property string     `json:"property,omitempty"` // Key of the property a tag is created or updated for
+	Tag      string     `json:"tag,omitempty"`      // Name of the tag created or updated
+	Changes  []string   `json:"changes,omitempty"`  // Changes made to the type or its properties
+
+	createType *anytype.CreateTypeParams
+	updateType *anytype.UpdateTypeParams
+	createTag  *anytype.CreateTagParams
+	updateTag  *anytype.UpdateTagParams
+}
+
+// String describes the action
+func (a Action) String() string {
+	switch a.Kind {
+	case ActionCreateType:
+		return fmt.Sprintf("+ create type %s (%s)%s", a.Type, a.createType.Name, describeChanges(" with ", a.Changes))
+	case ActionUpdateType:
+		return fmt.Sprintf("~ update type %s%s", a.Type, describeChanges(": ", a.Changes))
+	case ActionCreateTag:
+		color := ""
+		if a.createTag.Color != "" {
+			color = fmt.Sprintf(" (%s)", a.createTag.Color)
+		}
+		return fmt.Sprintf("+ create tag %q%s on property %s", a.Tag, color, a.Property)
+	case ActionUpdateTag:
+		return fmt.Sprintf("~ update tag %q on property %s: color %s", a.Tag, a.Property, *a.updateTag.Color)
+	default:
+		return string(a.Kind)
+	}
+}
+
+// describeChanges joins changes after a prefix, or returns an empty string if there are none
+func describeChanges(prefix string, changes []string) string {
+	if len(changes) == 0 {
+		return ""
+	}
+	return prefix + strings.Join(changes, ", ")
+}
+
+// Plan lists the actions needed to make a space match a manifest
+type Plan struct {
+	SpaceID string   `json:"space_id"` // Space the plan applies to
+	Actions []Action `json:"actions"`  // Actions in the order they are applied
+
+	propertyIDs map[string]string // Property key -> property ID in the space
+}
+
+// IsEmpty reports whether the space already matches the manifest
+func (p *Plan) IsEmpty() bool {
+	return len(p.Actions) == 0
+}
+
+// String describes the actions of the plan, one per line
+func (p *Plan) String() string {
+	if p.IsEmpty() {
+		return "No changes, the space matches the manifest"
+	}
+	lines := make([]string, len(p.Actions))
+	for i, action := range p.Actions {
+		lines[i] = action.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NewPlan compares a manifest with the types, properties and tags of a space.
+//
+// Types are matched by key, then by name. Missing types are created, and
+// existing types are updated when their name, plural name, layout or icon
+// differ, or when they lack some of the declared properties. Tags are matched
+// by name: missing tags are created and existing tags are recolored when the
+// manifest sets a different color. Declared properties are checked against
+// all the properties of the space, including those not used by any type.
+// NewPlan doesn't change the space, so it can be used as a dry run.
+//
+// Example:
+//
+//	manifest, err := schema.Load("schema.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	plan, err := schema.NewPlan(ctx, client, "space123", manifest)
+//	if err != nil {
+//	    log.Fatalf("Failed to plan schema changes: %v", err)
+//	}
+//	fmt.Println(plan)
+//
+//	if err := plan.Apply(ctx, client); err != nil {
+//	    log.Fatalf("Failed to apply schema: %v", err)
+//	}
+func NewPlan(ctx context.Context, client *anytype.Client, spaceID string, manifest *Manifest) (*Plan, error) {
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: manifest is required", ErrInvalidManifest)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	types, err := client.GetTypes(ctx, &anytype.GetTypesParams{SpaceID: spaceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get types: %w", err)
+	}
+
+	properties, err := client.GetProperties(ctx, spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get properties: %w", err)
+	}
+
+	plan := &Plan{SpaceID: spaceID, Actions: []Action{}, propertyIDs: make(map[string]string)}
+	formats := make(map[string]anytype.PropertyFormat)
+	for _, property := range properties.Data {
+		plan.propertyIDs[property.Key] = property.ID
+		formats[property.Key] = property.Format
+	}
+
+	for _, spec := range manifest.Types {
+		for _, property := range spec.Properties {
+			if format, ok := formats[property.Key]; ok && format != property.Format {
+				return nil, fmt.Errorf("%w: property %s has format %s in the space, not %s",
+					ErrInvalidManifest, property.Key, format, property.Format)
+			}
+		}
+
+		existing := findType(types.Data, spec)
+		if existing == nil {
+			plan.Actions = append(plan.Actions, createTypeAction(spaceID, spec))
+		} else if action := updateTypeAction(spaceID, spec, existing); action != nil {
+			plan.Actions = append(plan.Actions, *action)
+		}
+	}
+
+	tagActions, err := plan.tagActions(ctx, client, manifest)
+	if err != nil {
+		return nil, err
+	}
+	plan.Actions = append(plan.Actions, tagActions...)
+
+	return plan, nil
+}
+
+// findType returns the type of the space matching a type spec, nil if there is none
+func findType(types []anytype.TypeInfo, spec TypeSpec) *anytype.TypeInfo {
+	for i := range types {
+		if types[i].Key == spec.Key && !types[i].Archived {
+			return &types[i]
+		}
+	}
+	for i := range types {
+		if types[i].Name == spec.Name && !types[i].Archived {
+			return &types[i]
+		}
+	}
+	return nil
+}
+
+// createTypeAction returns the action creating a type
+func createTypeAction(spaceID string, spec TypeSpec) Action {
+	params := &anytype.CreateTypeParams{
+		SpaceID:    spaceID,
+		Key:        spec.Key,
+		Name:       spec.Name,
+		PluralName: spec.PluralName,
+		Layout:     spec.Layout,
+		Icon:       emojiIcon(spec.Icon),
+	}
+	var changes []string
+	for _, property := range spec.Properties {
+		params.Properties = append(params.Properties, propertyDefinition(property))
+		changes = append(changes, "+property "+property.Key)
+	}
+	return Action{Kind: ActionCreateType, Type: spec.Key, Changes: changes, createType: params}
+}
+
+// updateTypeAction returns the action updating a type to match its spec, nil if it already does
+func updateTypeAction(spaceID string, spec TypeSpec, existing *anytype.TypeInfo) *Action {
+	params := &anytype.UpdateTypeParams{SpaceID: spaceID, TypeID: existing.ID}
+	var changes []string
+
+	if spec.Name != existing.Name {
+		params.Name = &spec.Name
+		changes = append(changes, "name")
+	}
+	if spec.PluralName != "" && spec.PluralName != existing.PluralName {
+		params.PluralName = &spec.PluralName
+		changes = append(changes, "plural_name")
+	}
+	if spec.Layout != "" && spec.Layout != existing.Layout {
+		params.Layout = &spec.Layout
+		changes = append(changes, "layout")
+	}
+	if spec.Icon != "" && (existing.Icon == nil || existing.Icon.Emoji != spec.Icon) {
+		params.Icon = emojiIcon(spec.Icon)
+		changes = append(changes, "icon")
+	}
+
+	// The properties of a type are replaced as a whole, so missing properties
+	// are added to the ones the type already has
+	present := make(map[string]bool)
+	for _, property := range existing.Properties {
+		present[property.Key] = true
+	}
+	var missing []anytype.PropertyDefinition
+	for _, property := range spec.Properties {
+		if !present[property.Key] {
+			missing = append(missing, propertyDefinition(property))
+			changes = append(changes, "+property "+property.Key)
+		}
+	}
+	if len(missing) > 0 {
+		for _, property := range existing.Properties {
+			params.Properties = append(params.Properties, anytype.PropertyDefinition{
+				Key:    property.Key,
+				Name:   property.Name,
+				Format: property.Format,
+			})
+		}
+		params.Properties = append(params.Properties, missing...)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+	return &Action{Kind: ActionUpdateType, Type: spec.Key, Changes: changes, updateType: params}
+}
+
+// tagActions returns the actions creating the declared tags missing from the
+// space and recoloring the existing ones
+func (p *Plan) tagActions(ctx context.Context, client *anytype.Client, manifest *Manifest) ([]Action, error) {
+	var actions []Action
+	planned := make(map[string]bool) // property key + tag name
+
+	for _, spec := range manifest.Types {
+		for _, property := range spec.Properties {
+			if len(property.Tags) == 0 {
+				continue
+			}
+
+			var existing []anytype.PropertyTag
+			if propertyID, ok := p.propertyIDs[property.Key]; ok {
+				tags, err := client.GetTags(ctx, p.SpaceID, propertyID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get tags of property %s: %w", property.Key, err)
+				}
+				existing = tags.Data
+			}
+
+			for _, tag := range property.Tags {
+				id := property.Key + "\x00" + strings.ToLower(tag.Name)
+				if planned[id] {
+					continue
+				}
+				planned[id] = true
+
+				if current, found := findTag(existing, tag.Name); found {
+					if tag.Color != "" && anytype.TagColor(current.Color) != tag.Color {
+						color := tag.Color
+						actions = append(actions, Action{
+							Kind:     ActionUpdateTag,
+							Property: property.Key,
+							Tag:      current.Name,
+							updateTag: &anytype.UpdateTagParams{
+								SpaceID:    p.SpaceID,
+								PropertyID: p.propertyIDs[property.Key],
+								TagID:      current.ID,
+								Color:      &color,
+							},
+						})
+					}
+					continue
+				}
+				actions = append(actions, Action{
+					Kind:     ActionCreateTag,
+					Property: property.Key,
+					Tag:      tag.Name,
+					createTag: &anytype.CreateTagParams{
+						SpaceID: p.SpaceID,
+						Name:    tag.Name,
+						Color:   tag.Color,
+					},
+				})
+			}
+		}
+	}
+	return actions, nil
+}
+
+// findTag returns the tag with the given name, ignoring case
+func findTag(tags []anytype.PropertyTag, name string) (anytype.PropertyTag, bool) {
+	for _, tag := range tags {
+		if strings.EqualFold(tag.Name, name) {
+			return tag, true
+		}
+	}
+	return anytype.PropertyTag{}, false
+}
+
+// Apply performs the actions of the plan in order.
+//
+// Apply stops at the first failed action. Actions performed until then are
+// not rolled back; planning again returns the remaining actions.
+func (p *Plan) Apply(ctx context.Context, client *anytype.Client) error {
+	for _, action := range p.Actions {
+		if err := p.apply(ctx, client, action); err != nil {
+			return fmt.Errorf("%s: %w", action, err)
+		}
+	}
+	return nil
+}
+
+// apply performs a single action, recording the IDs of the properties it creates
+func (p *Plan) apply(ctx context.Context, client *anytype.Client, action Action) error {
+	var typeInfo *anytype.TypeInfo
+	var err error
+
+	switch action.Kind {
+	case ActionCreateType:
+		typeInfo, err = client.CreateType(ctx, action.createType)
+	case ActionUpdateType:
+		typeInfo, err = client.UpdateType(ctx, action.updateType)
+	case ActionCreateTag:
+		propertyID, ok := p.propertyIDs[action.Property]
+		if !ok {
+			return fmt.Errorf("property %s not found in space %s", action.Property, p.SpaceID)
+		}
+		params := *action.createTag
+		params.PropertyID = propertyID
+		_, err = client.CreateTag(ctx, &params)
+		return err
+	case ActionUpdateTag:
+		_, err = client.UpdateTag(ctx, action.updateTag)
+		return err
+	default:
+		return fmt.Errorf("unknown action %q", action.Kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, property := range typeInfo.Properties {
+		p.propertyIDs[property.Key] = property.ID
+	}
+	return nil
+}
+
+// propertyDefinition converts a property spec to the definition sent with a type
+func propertyDefinition(spec PropertySpec) anytype.PropertyDefinition {
+	return anytype.PropertyDefinition{Key: spec.Key, Name: spec.Name, Format: spec.Format}
+}
+
+// emojiIcon returns an emoji icon, nil if emoji is empty
+func emojiIcon(emoji string) *anytype.Icon {
+	if emoji == "" {
+		return nil
+	}
+	return &anytype.Icon{Format: "emoji", Emoji: emoji}
+}
@@ -0,0 +1,174 @@
+// Package schema provisions types, properties and tags in a space from a
+// declarative manifest.
+//
+// A manifest lists the types of a space with their properties, and the tags
+// and colors of their select and multi_select properties. NewPlan compares the manifest
+// with the space and returns the actions needed to make the space match it,
+// which Plan.Apply then performs. Provisioning is additive: types,
+// properties and tags missing from the manifest are left untouched.
+//
+// Manifests are YAML or JSON documents with the same field names:
+//
+//	types:
+//	  - key: incident
+//	    name: Incident
+//	    plural_name: Incidents
+//	    layout: basic
+//	    icon: 🚨
+//	    properties:
+//	      - key: severity
+//	        name: Severity
+//	        format: select
+//	        tags:
+//	          - {name: SEV1, color: red}
+//	          - {name: SEV2, color: orange}
+//	      - {key: resolved_at, name: Resolved at, format: date}
+//
+// or, as JSON:
+//
+//	{
+//	  "types": [
+//	    {
+//	      "key": "incident",
+//	      "name": "Incident",
+//	      "plural_name": "Incidents",
+//	      "layout": "basic",
+//	      "icon": "🚨",
+//	      "properties": [
+//	        {"key": "severity", "name": "Severity", "format": "select",
+//	         "tags": [{"name": "SEV1", "color": "red"}, {"name": "SEV2", "color": "orange"}]},
+//	        {"key": "resolved_at", "name": "Resolved at", "format": "date"}
+//	      ]
+//	    }
+//	  ]
+//	}
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/epheo/anytype-go/internal/yamljson"
+	"github.com/epheo/anytype-go/pkg/anytype"
+)
+
+// ErrInvalidManifest is returned for manifests that can't be applied
+var ErrInvalidManifest = errors.New("invalid schema manifest")
+
+// Manifest declares the types of a space
+type Manifest struct {
+	Types []TypeSpec `json:"types"` // Types to provision
+}
+
+// TypeSpec declares a type
+type TypeSpec struct {
+	Key        string             `json:"key"`                   // Key of the type, used to find it in the space
+	Name       string             `json:"name"`                  // Display name of the type
+	PluralName string             `json:"plural_name,omitempty"` // Plural display name of the type
+	Layout     anytype.TypeLayout `json:"layout,omitempty"`      // Layout of objects of the type
+	Icon       string             `json:"icon,omitempty"`        // Emoji icon of the type
+	Properties []PropertySpec     `json:"properties,omitempty"`  // Properties of objects of the type
+}
+
+// PropertySpec declares a property of a type
+type PropertySpec struct {
+	Key    string                 `json:"key"`            // Key of the property
+	Name   string                 `json:"name"`           // Display name of the property
+	Format anytype.PropertyFormat `json:"format"`         // Format of the property values
+	Tags   []TagSpec              `json:"tags,omitempty"` // Tags of a select or multi_select property
+}
+
+// TagSpec declares a tag of a select or multi_select property
+type TagSpec struct {
+	Name  string           `json:"name"`            // Name of the tag
+	Color anytype.TagColor `json:"color,omitempty"` // Color of the tag
+}
+
+// Load reads a manifest from a YAML or JSON file
+func Load(path string) (*Manifest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema manifest: %w", err)
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse reads a manifest from YAML or JSON and validates it.
+// Documents starting with '{' are read as JSON, others as YAML.
+// Unknown fields are rejected to catch typos.
+func Parse(r io.Reader) (*Manifest, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema manifest: %w", err)
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if data, err = yamljson.ToJSON(data); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var manifest Manifest
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// Validate checks that types and properties are complete and consistent.
+// A property used by several types must have the same format in all of them.
+func (m *Manifest) Validate() error {
+	typeKeys := make(map[string]bool)
+	formats := make(map[string]anytype.PropertyFormat)
+
+	for _, t := range m.Types {
+		if t.Key == "" || strings.TrimSpace(t.Name) == "" {
+			return fmt.Errorf("%w: types need a key and a name", ErrInvalidManifest)
+		}
+		if typeKeys[t.Key] {
+			return fmt.Errorf("%w: type %s is declared twice", ErrInvalidManifest, t.Key)
+		}
+		typeKeys[t.Key] = true
+		if t.Layout != "" && !t.Layout.IsValid() {
+			return fmt.Errorf("%w: type %s has an invalid layout %q", ErrInvalidManifest, t.Key, t.Layout)
+		}
+
+		for _, p := range t.Properties {
+			if p.Key == "" || strings.TrimSpace(p.Name) == "" || p.Format == "" {
+				return fmt.Errorf("%w: properties of type %s need a key, a name and a format", ErrInvalidManifest, t.Key)
+			}
+			if !p.Format.IsValid() {
+				return fmt.Errorf("%w: property %s has an invalid format %q", ErrInvalidManifest, p.Key, p.Format)
+			}
+			if format, ok := formats[p.Key]; ok && format != p.Format {
+				return fmt.Errorf("%w: property %s is declared as both %s and %s", ErrInvalidManifest, p.Key, format, p.Format)
+			}
+			formats[p.Key] = p.Format
+
+			if len(p.Tags) > 0 && !p.Format.HasTags() {
+				return fmt.Errorf("%w: property %s has tags but is not a select or multi_select property", ErrInvalidManifest, p.Key)
+			}
+			for _, tag := range p.Tags {
+				if strings.TrimSpace(tag.Name) == "" {
+					return fmt.Errorf("%w: tags of property %s need a name", ErrInvalidManifest, p.Key)
+				}
+				if tag.Color != "" && !tag.Color.IsValid() {
+					return fmt.Errorf("%w: tag %s of property %s has an invalid color %q", ErrInvalidManifest, tag.Name, p.Key, tag.Color)
+				}
+			}
+		}
+	}
+	return nil
+}
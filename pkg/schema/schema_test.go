@@ -0,0 +1,270 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/epheo/anytype-go/pkg/anytype"
+)
+
+const testManifest = `{
+  "types": [
+    {
+      "key": "incident",
+      "name": "Incident",
+      "layout": "basic",
+      "icon": "🚨",
+      "properties": [
+        {"key": "severity", "name": "Severity", "format": "select",
+         "tags": [{"name": "SEV1", "color": "red"}, {"name": "SEV2", "color": "orange"}]},
+        {"key": "resolved_at", "name": "Resolved at", "format": "date"}
+      ]
+    },
+    {
+      "key": "runbook",
+      "name": "Runbook",
+      "properties": [
+        {"key": "severity", "name": "Severity", "format": "select", "tags": [{"name": "sev2"}]},
+        {"key": "team", "name": "Team", "format": "select", "tags": [{"name": "SRE", "color": "blue"}]}
+      ]
+    }
+  ]
+}`
+
+// testYAMLManifest is testManifest written in YAML
+const testYAMLManifest = `
+types:
+  - key: incident
+    name: Incident
+    layout: basic
+    icon: 🚨
+    properties:
+      - key: severity
+        name: Severity
+        format: select
+        tags:
+          - {name: SEV1, color: red}
+          - {name: SEV2, color: orange}
+      - {key: resolved_at, name: Resolved at, format: date}
+  - key: runbook
+    name: Runbook
+    properties:
+      - {key: severity, name: Severity, format: select, tags: [{name: sev2}]}
+      - {key: team, name: Team, format: select, tags: [{name: SRE, color: blue}]}
+`
+
+// schemaServer fakes the type and tag endpoints of a space
+type schemaServer struct {
+	types      []anytype.TypeInfo
+	properties []anytype.PropertyDefinition
+	tags       map[string][]anytype.PropertyTag // Property ID -> tags
+	requests   []string                         // Method and path of the write requests
+	bodies     []map[string]interface{}         // Bodies of the write requests
+}
+
+// setupSchemaServer sets up a space with an incident type that has a severity property with a SEV1 tag
+func setupSchemaServer(t *testing.T) (*httptest.Server, *schemaServer, *anytype.Client) {
+	state := &schemaServer{
+		types: []anytype.TypeInfo{
+			{ID: "type1", Key: "ot-page", Name: "Page", Layout: anytype.TypeLayoutBasic},
+			{
+				ID: "type2", Key: "incident", Name: "Incident", Layout: anytype.TypeLayoutBasic,
+				Icon:       &anytype.Icon{Format: "emoji", Emoji: "🚨"},
+				Properties: []anytype.PropertyDefinition{{ID: "prop1", Key: "severity", Name: "Severity", Format: "select"}},
+			},
+		},
+		properties: []anytype.PropertyDefinition{{ID: "prop1", Key: "severity", Name: "Severity", Format: "select"}},
+		tags:       map[string][]anytype.PropertyTag{"prop1": {{ID: "tag1", Name: "SEV1", Color: "red"}}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			if strings.HasSuffix(r.URL.Path, "/types") {
+				json.NewEncoder(w).Encode(anytype.TypeResponse{Data: state.types, Pagination: anytype.Pagination{Total: len(state.types)}})
+				return
+			}
+			if strings.HasSuffix(r.URL.Path, "/properties") {
+				json.NewEncoder(w).Encode(anytype.PropertiesResponse{Data: state.properties, Pagination: anytype.Pagination{Total: len(state.properties)}})
+				return
+			}
+			parts := strings.Split(r.URL.Path, "/")
+			tags := state.tags[parts[len(parts)-2]]
+			json.NewEncoder(w).Encode(anytype.TagsResponse{Data: tags, Pagination: anytype.Pagination{Total: len(tags)}})
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		state.requests = append(state.requests, r.Method+" "+r.URL.Path)
+		state.bodies = append(state.bodies, body)
+
+		if strings.HasSuffix(r.URL.Path, "/tags") {
+			json.NewEncoder(w).Encode(map[string]interface{}{"tag": anytype.PropertyTag{ID: "new", Name: body["name"].(string)}})
+			return
+		}
+
+		// Types are returned with IDs for all their properties
+		typeInfo := anytype.TypeInfo{ID: "new-type"}
+		properties, _ := body["properties"].([]interface{})
+		for _, raw := range properties {
+			property := raw.(map[string]interface{})
+			key := property["key"].(string)
+			id := "prop-" + key
+			if key == "severity" {
+				id = "prop1"
+			}
+			typeInfo.Properties = append(typeInfo.Properties, anytype.PropertyDefinition{ID: id, Key: key})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"type": typeInfo})
+	}))
+
+	client, err := anytype.NewClient(anytype.WithURL(server.URL), anytype.WithAppKey("test-app-key"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return server, state, client
+}
+
+// TestParse tests reading and validating manifests
+func TestParse(t *testing.T) {
+	manifest, err := Parse(strings.NewReader(testManifest))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(manifest.Types) != 2 || len(manifest.Types[0].Properties[0].Tags) != 2 {
+		t.Errorf("Unexpected manifest: %+v", manifest)
+	}
+
+	// The same manifest in YAML
+	yamlManifest, err := Parse(strings.NewReader(testYAMLManifest))
+	if err != nil {
+		t.Fatalf("Parse failed for YAML: %v", err)
+	}
+	if !reflect.DeepEqual(yamlManifest, manifest) {
+		t.Errorf("Unexpected YAML manifest:\n%+v\nexpected:\n%+v", yamlManifest, manifest)
+	}
+
+	invalid := []string{
+		`{"types": [{"key": "incident"}]}`,
+		`{"types": [{"key": "incident", "name": "Incident", "colour": "red"}]}`,
+		`{"types": [{"key": "incident", "name": "Incident"}, {"key": "incident", "name": "Other"}]}`,
+		`{"types": [{"key": "incident", "name": "Incident", "layout": "grid"}]}`,
+		`{"types": [{"key": "incident", "name": "Incident", "properties": [{"key": "severity", "name": "Severity"}]}]}`,
+		`{"types": [{"key": "incident", "name": "Incident", "properties": [{"key": "due", "name": "Due", "format": "date", "tags": [{"name": "x"}]}]}]}`,
+		`{"types": [{"key": "incident", "name": "Incident", "properties": [{"key": "severity", "name": "Severity", "format": "select", "tags": [{"name": "x", "color": "gold"}]}]}]}`,
+		`{"types": [
+			{"key": "incident", "name": "Incident", "properties": [{"key": "severity", "name": "Severity", "format": "select"}]},
+			{"key": "runbook", "name": "Runbook", "properties": [{"key": "severity", "name": "Severity", "format": "text"}]}
+		]}`,
+		"types:\n  - key: incident\n    name: Incident\n    colour: red\n",
+		"types: [unclosed",
+	}
+	for _, manifest := range invalid {
+		if _, err := Parse(strings.NewReader(manifest)); !errors.Is(err, ErrInvalidManifest) {
+			t.Errorf("Expected ErrInvalidManifest for %s, got %v", manifest, err)
+		}
+	}
+}
+
+// TestPlanAndApply tests that only the missing types, properties and tags are planned and created
+func TestPlanAndApply(t *testing.T) {
+	server, state, client := setupSchemaServer(t)
+	defer server.Close()
+
+	manifest, err := Parse(strings.NewReader(testManifest))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	plan, err := NewPlan(context.Background(), client, "space123", manifest)
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+	if len(state.requests) != 0 {
+		t.Fatalf("Expected planning not to change the space, got %v", state.requests)
+	}
+
+	expected := []string{
+		"~ update type incident: +property resolved_at",
+		"+ create type runbook (Runbook) with +property severity, +property team",
+		`+ create tag "SEV2" (orange) on property severity`,
+		`+ create tag "SRE" (blue) on property team`,
+	}
+	if plan.String() != strings.Join(expected, "\n") {
+		t.Fatalf("Unexpected plan:\n%s", plan)
+	}
+
+	if err := plan.Apply(context.Background(), client); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	expectedRequests := []string{
+		"PATCH /v1/spaces/space123/types/type2",
+		"POST /v1/spaces/space123/types",
+		"POST /v1/spaces/space123/properties/prop1/tags",
+		"POST /v1/spaces/space123/properties/prop-team/tags",
+	}
+	if strings.Join(state.requests, "\n") != strings.Join(expectedRequests, "\n") {
+		t.Fatalf("Unexpected requests: %v", state.requests)
+	}
+
+	// The existing properties of the type are kept when adding new ones
+	properties, _ := state.bodies[0]["properties"].([]interface{})
+	if len(state.bodies[0]) != 1 || len(properties) != 2 {
+		t.Errorf("Expected only the properties to be updated, got %v", state.bodies[0])
+	}
+	if state.bodies[1]["key"] != "runbook" || state.bodies[1]["layout"] != "basic" {
+		t.Errorf("Unexpected created type: %v", state.bodies[1])
+	}
+}
+
+// TestPlanEmpty tests that a space matching the manifest needs no changes
+func TestPlanEmpty(t *testing.T) {
+	server, state, client := setupSchemaServer(t)
+	defer server.Close()
+
+	manifest := &Manifest{Types: []TypeSpec{{
+		Key:        "incident",
+		Name:       "Incident",
+		Properties: []PropertySpec{{Key: "severity", Name: "Severity", Format: "select", Tags: []TagSpec{{Name: "sev1"}}}},
+	}}}
+	plan, err := NewPlan(context.Background(), client, "space123", manifest)
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+	if !plan.IsEmpty() {
+		t.Errorf("Expected no changes, got:\n%s", plan)
+	}
+
+	// Existing tags are recolored
+	manifest.Types[0].Properties[0].Tags[0].Color = anytype.TagColorPurple
+	plan, err = NewPlan(context.Background(), client, "space123", manifest)
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+	if plan.String() != `~ update tag "SEV1" on property severity: color purple` {
+		t.Errorf("Unexpected plan:\n%s", plan)
+	}
+
+	// Property formats can't be changed
+	manifest.Types[0].Properties[0].Format = "multi_select"
+	manifest.Types[0].Properties[0].Tags = nil
+	if _, err := NewPlan(context.Background(), client, "space123", manifest); !errors.Is(err, ErrInvalidManifest) {
+		t.Errorf("Expected ErrInvalidManifest for a format change, got %v", err)
+	}
+
+	// Properties of the space not used by any type are checked too
+	state.properties = append(state.properties, anytype.PropertyDefinition{ID: "prop2", Key: "owner", Name: "Owner", Format: "text"})
+	manifest.Types[0].Properties = []PropertySpec{{Key: "owner", Name: "Owner", Format: "objects"}}
+	if _, err := NewPlan(context.Background(), client, "space123", manifest); !errors.Is(err, ErrInvalidManifest) {
+		t.Errorf("Expected ErrInvalidManifest for a format change of an unused property, got %v", err)
+	}
+}